@@ -70,12 +70,21 @@ func HandleAll(args []string) {
 	// Get base directory
 	baseDir := filepath.Dir(drawingsPath)
 
+	// Fonts to embed (typography.fontFamilies.*.embed)
+	fonts := loadEmbeddedFonts(standardsPath)
+
 	// Inject CSS into each SVG file
 	for _, file := range cfg.Drawings.Files {
 		svgPath := filepath.Join(baseDir, cfg.Drawings.BasePath, file.Path)
 		fmt.Printf("  Injecting: %s\n", svgPath)
 
-		if err := injector.InjectCSS(svgPath, css); err != nil {
+		fontCSS, err := injector.FontFaceCSS(svgPath, fonts)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "  ✗ Error embedding fonts: %v\n", err)
+			continue
+		}
+
+		if err := injector.InjectCSS(svgPath, css+fontCSS); err != nil {
 			fmt.Fprintf(os.Stderr, "  ✗ Error: %v\n", err)
 			continue
 		}
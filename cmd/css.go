@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/joeblew999/mon-house/internal/config"
 	"github.com/joeblew999/mon-house/internal/generator"
@@ -34,12 +35,15 @@ func HandleCSS(args []string) {
 func printCSSUsage() {
 	fmt.Println("CSS commands:")
 	fmt.Println("  css generate [standards.json]    Generate CSS from drawing-standards.json")
-	fmt.Println("  css inject [css-file]             Inject CSS into SVG files (uses drawings.json)")
+	fmt.Println("  css inject <css-file> [svg-files...] [--standards=drawing-standards.json]")
+	fmt.Println("                                    Inject CSS into SVG files (default: drawings.json),")
+	fmt.Println("                                    embedding fonts from typography.fontFamilies.*.embed")
 	fmt.Println()
 	fmt.Println("Examples:")
 	fmt.Println("  mon-tool css generate > drawing-standards_gen.css")
 	fmt.Println("  mon-tool css generate drawing-standards.json > output.css")
 	fmt.Println("  mon-tool css inject drawing-standards_gen.css")
+	fmt.Println("  mon-tool css inject drawing-standards_gen.css drawings/th/plan.svg")
 }
 
 func handleCSSGenerate(args []string) {
@@ -68,12 +72,22 @@ func handleCSSGenerate(args []string) {
 }
 
 func handleCSSInject(args []string) {
-	if len(args) < 1 {
-		fmt.Fprintf(os.Stderr, "Usage: mon-tool css inject <css-file>\n")
+	// Parse flags: css file, optional SVG files, --standards=
+	standardsPath := ""
+	var positional []string
+	for _, arg := range args {
+		if strings.HasPrefix(arg, "--standards=") {
+			standardsPath = strings.TrimPrefix(arg, "--standards=")
+			continue
+		}
+		positional = append(positional, arg)
+	}
+	if len(positional) < 1 {
+		fmt.Fprintf(os.Stderr, "Usage: mon-tool css inject <css-file> [svg-files...] [--standards=drawing-standards.json]\n")
 		os.Exit(1)
 	}
 
-	cssPath := args[0]
+	cssPath := positional[0]
 	drawingsPath := "drawings.json"
 
 	// Read CSS file
@@ -83,23 +97,36 @@ func handleCSSInject(args []string) {
 		os.Exit(1)
 	}
 
-	// Read drawings config
-	cfg, err := config.LoadDrawingsConfig(drawingsPath)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error reading drawings config: %v\n", err)
-		os.Exit(1)
+	// SVG files: given on the command line, or listed in drawings.json
+	svgPaths := positional[1:]
+	if len(svgPaths) == 0 {
+		cfg, err := config.LoadDrawingsConfig(drawingsPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading drawings config: %v\n", err)
+			os.Exit(1)
+		}
+
+		// Get base directory (where drawings.json is located)
+		baseDir := filepath.Dir(drawingsPath)
+		for _, file := range cfg.Drawings.Files {
+			svgPaths = append(svgPaths, filepath.Join(baseDir, cfg.Drawings.BasePath, file.Path))
+		}
 	}
 
-	// Get base directory (where drawings.json is located)
-	baseDir := filepath.Dir(drawingsPath)
+	// Fonts to embed (typography.fontFamilies.*.embed)
+	fonts := loadEmbeddedFonts(standardsPath)
 
 	// Process each SVG file
-	for _, file := range cfg.Drawings.Files {
-		svgPath := filepath.Join(baseDir, cfg.Drawings.BasePath, file.Path)
-
+	for _, svgPath := range svgPaths {
 		fmt.Printf("Injecting CSS into: %s\n", svgPath)
 
-		if err := injector.InjectCSS(svgPath, string(cssContent)); err != nil {
+		fontCSS, err := injector.FontFaceCSS(svgPath, fonts)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "  ✗ Error embedding fonts: %v\n", err)
+			continue
+		}
+
+		if err := injector.InjectCSS(svgPath, string(cssContent)+fontCSS); err != nil {
 			fmt.Fprintf(os.Stderr, "  ✗ Error: %v\n", err)
 			continue
 		}
@@ -107,3 +134,33 @@ func handleCSSInject(args []string) {
 		fmt.Printf("  ✓ Success\n")
 	}
 }
+
+// loadEmbeddedFonts reads the fonts to embed from drawing-standards.json
+// Without an explicit path it looks in the current dir, then ../code/ (like HandleAll);
+// a missing standards file means no fonts are embedded
+func loadEmbeddedFonts(standardsPath string) []injector.EmbeddedFont {
+	if standardsPath == "" {
+		standardsPath = "drawing-standards.json"
+		if _, err := os.Stat(standardsPath); os.IsNotExist(err) {
+			standardsPath = "../code/drawing-standards.json"
+		}
+		if _, err := os.Stat(standardsPath); os.IsNotExist(err) {
+			return nil
+		}
+	}
+
+	input, err := config.LoadJSON(standardsPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", standardsPath, err)
+		os.Exit(1)
+	}
+	fonts, err := injector.LoadEmbeddedFonts(input, filepath.Dir(standardsPath))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error in %s: %v\n", standardsPath, err)
+		os.Exit(1)
+	}
+	for _, font := range fonts {
+		fmt.Printf("Embedding font %s as %q\n", font.Path, font.Family)
+	}
+	return fonts
+}
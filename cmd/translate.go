@@ -1,10 +1,14 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"os/signal"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/joeblew999/mon-house/pkg/translate"
@@ -36,21 +40,166 @@ func HandleTranslate(args []string) {
 			os.Exit(1)
 		}
 		dryRun := false
-		if len(args) > 2 && args[2] == "--dry-run" {
-			dryRun = true
+		force := false
+		onlyApproved := false
+		for _, arg := range args[2:] {
+			switch {
+			case arg == "--dry-run":
+				dryRun = true
+			case arg == "--force":
+				force = true
+			case arg == "--only=approved":
+				onlyApproved = true
+			case strings.HasPrefix(arg, "--only="):
+				fmt.Fprintf(os.Stderr, "Error: unsupported %s (only --only=approved)\n", arg)
+				os.Exit(1)
+			}
 		}
-		handleTranslateApply(args[1], dryRun)
+		handleTranslateApply(args[1], dryRun, force, onlyApproved)
 	case "auto":
 		if len(args) < 2 {
 			fmt.Fprintf(os.Stderr, "Error: translate auto requires a task file path\n\n")
 			printTranslateUsage()
 			os.Exit(1)
 		}
-		apiKey := os.Getenv("ANTHROPIC_API_KEY")
-		if len(args) > 2 && strings.HasPrefix(args[2], "--api-key=") {
-			apiKey = strings.TrimPrefix(args[2], "--api-key=")
+		handleTranslateAuto(args[1], parseAutoFlags(args[2:]))
+	case "full":
+		flags := fullFlags{auto: parseAutoFlags(args[1:])}
+		for _, arg := range args[1:] {
+			switch {
+			case strings.HasPrefix(arg, "--language="):
+				flags.language = strings.TrimPrefix(arg, "--language=")
+			case arg == "--restart":
+				flags.restart = true
+			case arg == "--force":
+				flags.force = true
+			}
+		}
+		handleTranslateFull(flags)
+	case "export":
+		format := "xliff"
+		output := ""
+		taskFile := ""
+		for _, arg := range args[1:] {
+			switch {
+			case strings.HasPrefix(arg, "--format="):
+				format = strings.TrimPrefix(arg, "--format=")
+			case strings.HasPrefix(arg, "--output="):
+				output = strings.TrimPrefix(arg, "--output=")
+			default:
+				taskFile = arg
+			}
+		}
+		if taskFile == "" {
+			fmt.Fprintf(os.Stderr, "Error: translate export requires a task file path\n\n")
+			printTranslateUsage()
+			os.Exit(1)
 		}
-		handleTranslateAuto(args[1], apiKey)
+		handleTranslateExport(taskFile, format, output)
+	case "import":
+		if len(args) < 2 {
+			fmt.Fprintf(os.Stderr, "Error: translate import requires an exchange file path\n\n")
+			printTranslateUsage()
+			os.Exit(1)
+		}
+		taskFile := ""
+		if len(args) > 2 {
+			taskFile = args[2]
+		}
+		handleTranslateImport(args[1], taskFile)
+	case "verify":
+		if len(args) < 2 {
+			fmt.Fprintf(os.Stderr, "Error: translate verify requires a task file path\n\n")
+			printTranslateUsage()
+			os.Exit(1)
+		}
+		flags := verifyFlags{top: 10, auto: parseAutoFlags(args[2:])}
+		for _, arg := range args[2:] {
+			switch {
+			case strings.HasPrefix(arg, "--threshold="):
+				threshold, err := parseThreshold(strings.TrimPrefix(arg, "--threshold="))
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+					os.Exit(1)
+				}
+				flags.threshold = threshold
+			case strings.HasPrefix(arg, "--top="):
+				top, err := strconv.Atoi(strings.TrimPrefix(arg, "--top="))
+				if err != nil || top < 1 {
+					fmt.Fprintf(os.Stderr, "Error: invalid --top %q (number of results, e.g. 20)\n", strings.TrimPrefix(arg, "--top="))
+					os.Exit(1)
+				}
+				flags.top = top
+			case arg == "--all":
+				flags.all = true
+			}
+		}
+		handleTranslateVerify(args[1], flags)
+	case "review":
+		if len(args) < 2 {
+			fmt.Fprintf(os.Stderr, "Error: translate review requires a task file path\n\n")
+			printTranslateUsage()
+			os.Exit(1)
+		}
+		flags := reviewFlags{reviewer: os.Getenv("USER")}
+		for _, arg := range args[2:] {
+			switch {
+			case arg == "approve" || arg == "reject":
+				flags.action = arg
+			case arg == "--all":
+				flags.all = true
+			case strings.HasPrefix(arg, "--status="):
+				flags.status = strings.TrimPrefix(arg, "--status=")
+			case strings.HasPrefix(arg, "--reviewer="):
+				flags.reviewer = strings.TrimPrefix(arg, "--reviewer=")
+			case strings.HasPrefix(arg, "--note="):
+				flags.note = strings.TrimPrefix(arg, "--note=")
+			case !strings.HasPrefix(arg, "--"):
+				flags.ids = append(flags.ids, arg)
+			}
+		}
+		if flags.reviewer == "" {
+			flags.reviewer = "unknown"
+		}
+		handleTranslateReview(args[1], flags)
+	case "qa":
+		taskFile := ""
+		if len(args) > 1 {
+			taskFile = args[1]
+		}
+		handleTranslateQA(taskFile)
+	case "glossary":
+		handleTranslateGlossary(args[1:])
+	case "cache":
+		handleTranslateCache(args[1:])
+	case "costs":
+		handleTranslateCosts()
+	case "status":
+		language := ""
+		jsonOutput, all := false, false
+		for _, arg := range args[1:] {
+			switch {
+			case strings.HasPrefix(arg, "--language="):
+				language = strings.TrimPrefix(arg, "--language=")
+			case arg == "--json":
+				jsonOutput = true
+			case arg == "--all":
+				all = true
+			}
+		}
+		handleTranslateStatus(language, jsonOutput, all)
+	case "fit":
+		language := ""
+		fix := false
+		for _, arg := range args[1:] {
+			switch {
+			case strings.HasPrefix(arg, "--language="):
+				language = strings.TrimPrefix(arg, "--language=")
+			case arg == "--fix":
+				fix = true
+			}
+		}
+		handleTranslateFit(language, fix)
 	case "events":
 		handleTranslateEvents()
 	case "help", "-h", "--help":
@@ -67,8 +216,28 @@ func printTranslateUsage() {
 	fmt.Println("  translate sync           Extract text and generate task files")
 	fmt.Println("  translate sync --dry-run Preview extraction without changes")
 	fmt.Println("  translate auto <file>    AI translation (headless, requires API key)")
+	fmt.Println("  translate auto <file> --workers=4 --batch-tokens=2500  Parallel requests, batch size")
+	fmt.Println("  translate auto <file> --provider=openai --model=llama3.1 --endpoint=http://localhost:11434/v1")
+	fmt.Println("  translate auto <file> --provider=dictionary  Offline: glossary/dictionary or lang: echo")
+	fmt.Println("  translate auto <file> --no-cache  Ignore cached AI responses")
+	fmt.Println("  translate full [--language=th]  sync + auto + apply in one go (resumes a failed run)")
+	fmt.Println("  translate full --restart         Ignore checkpoints and start again from sync")
 	fmt.Println("  translate apply <file>   Apply translations from task file")
 	fmt.Println("  translate apply <file> --dry-run  Preview application")
+	fmt.Println("  translate apply <file> --force    Apply even if QA finds errors")
+	fmt.Println("  translate apply <file> --only=approved  Apply only translations approved in review")
+	fmt.Println("  translate verify <file> [--threshold=0.5] [--top=10] [--all]  Back-translate AI output, flag drift (threshold 0-1, 0.5 = 50%)")
+	fmt.Println("  translate review <file>  List translations waiting for review (--status=machine)")
+	fmt.Println("  translate review <file> approve|reject <id>...|--all  Sign off or discard translations")
+	fmt.Println("  translate qa [file]      Lint a task (or applied translations) for QA issues")
+	fmt.Println("  translate export <file> --format=xliff  Export task for CAT tools (XLIFF 2.0)")
+	fmt.Println("  translate export <file> --format=po     Export task as gettext PO (Poedit)")
+	fmt.Println("  translate import <file.xlf|.po> [task] Merge reviewed translations into task")
+	fmt.Println("  translate glossary list|add|check  Manage the terminology glossary")
+	fmt.Println("  translate cache stats|prune  Inspect or prune the AI response cache")
+	fmt.Println("  translate costs          AI spending by model, language and month")
+	fmt.Println("  translate status [--language=th] [--json]  Coverage, stale/missing/orphaned files")
+	fmt.Println("  translate fit [--language=th] [--fix]  Check translated labels fit their drawings")
 	fmt.Println("  translate events         View event log (audit trail)")
 	fmt.Println()
 	fmt.Println("Manual Translation Flow:")
@@ -76,11 +245,18 @@ func printTranslateUsage() {
 	fmt.Println("  2. Edit tasks/translate-th.json manually       # Fill translations")
 	fmt.Println("  3. mon-tool translate apply tasks/translate-th.json  # Apply")
 	fmt.Println()
+	fmt.Println("CAT Tool Review Flow:")
+	fmt.Println("  1. mon-tool translate export tasks/translate-th.json --format=xliff")
+	fmt.Println("  2. Reviewer translates tasks/translate-th.xlf in their CAT tool")
+	fmt.Println("  3. mon-tool translate import tasks/translate-th.xlf  # Merge into task")
+	fmt.Println("  4. mon-tool translate apply tasks/translate-th.json  # Apply")
+	fmt.Println()
 	fmt.Println("Headless AI Translation Flow:")
 	fmt.Println("  1. export ANTHROPIC_API_KEY=sk-ant-...")
 	fmt.Println("  2. mon-tool translate sync                     # Extract text")
 	fmt.Println("  3. mon-tool translate auto tasks/translate-th.json   # AI translates")
 	fmt.Println("  4. mon-tool translate apply tasks/translate-th.json  # Apply")
+	fmt.Println("  (or all three: mon-tool translate full --language=th)")
 	fmt.Println()
 	fmt.Println("Examples:")
 	fmt.Println("  mon-tool translate sync                        # Extract")
@@ -106,11 +282,19 @@ func handleTranslateSync(dryRun bool) {
 		os.Exit(1)
 	}
 
-	// Step 3: Verify source directory exists
-	sourceDir := filepath.Join(rootDir, config.Source.Folder)
-	if _, err := os.Stat(sourceDir); os.IsNotExist(err) {
-		fmt.Fprintf(os.Stderr, "Error: %s directory not found\n", config.Source.Folder)
-		os.Exit(1)
+	// Step 3: Verify source directories exist
+	var sourceFolders []string
+	if config.Source.Folder != "" {
+		sourceFolders = append(sourceFolders, config.Source.Folder)
+	}
+	for _, folder := range config.Source.Folders {
+		sourceFolders = append(sourceFolders, folder.Source)
+	}
+	for _, folder := range sourceFolders {
+		if _, err := os.Stat(filepath.Join(rootDir, folder)); os.IsNotExist(err) {
+			fmt.Fprintf(os.Stderr, "Error: %s directory not found\n", folder)
+			os.Exit(1)
+		}
 	}
 
 	// Step 4: Create event store (Event Sourcing - Phase 3, path from config)
@@ -125,16 +309,23 @@ func handleTranslateSync(dryRun bool) {
 		}
 	}()
 
-	// Step 5: Create command handler with event store
-	syncHandler := commands.NewSyncHandler(eventStore)
+	// Step 5: Load translation memory (path from config, next to events)
+	memory, err := translate.LoadMemory(rootDir, config.Paths.Events)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to load translation memory: %v\n", err)
+		memory = nil // Continue without translation memory
+	}
 
-	// Step 6: Process each target language using COMMANDS
+	// Step 6: Create command handler with event store and memory
+	syncHandler := commands.NewSyncHandler(eventStore, memory)
+
+	// Step 7: Process each target language using COMMANDS
 	for _, target := range config.Targets {
 		fmt.Printf("\n━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
 		fmt.Printf("🌐 Syncing %s → %s (%s)\n", config.Source.Language, target.Language, target.Folder)
 		fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n\n")
 
-		// Step 6a: Create COMMAND object (intent to sync)
+		// Step 7a: Create COMMAND object (intent to sync)
 		cmd := &commands.SyncCommand{
 			RootDir:    rootDir,
 			SourceLang: config.Source.Language,
@@ -142,15 +333,15 @@ func handleTranslateSync(dryRun bool) {
 			DryRun:     dryRun,
 		}
 
-		// Step 6b: Execute COMMAND via handler
-		fmt.Printf("📂 Scanning %s ...\n", config.Source.Folder)
+		// Step 7b: Execute COMMAND via handler
+		fmt.Printf("📂 Scanning %s ...\n", strings.Join(sourceFolders, ", "))
 		result, err := syncHandler.Handle(cmd)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error executing sync command: %v\n", err)
 			os.Exit(1)
 		}
 
-		// Step 5c: Display results
+		// Step 7c: Display results
 		fmt.Println()
 		if dryRun {
 			fmt.Println("🔍 DRY RUN - No changes will be made")
@@ -162,11 +353,38 @@ func handleTranslateSync(dryRun bool) {
 			result.DirectoriesCreated, result.FilesCopied, result.FilesDeleted)
 		fmt.Println()
 
-		// Step 5d: Show generated tasks
+		if result.TargetsMerged > 0 {
+			if dryRun {
+				fmt.Printf("✍️  %d targets were edited by hand; their edits will be merged\n", result.TargetsMerged)
+			} else {
+				fmt.Printf("✍️  Merged hand edits back into %d targets\n", result.TargetsMerged)
+			}
+			if result.MergeConflicts > 0 {
+				fmt.Printf("⚠️  %d merge conflicts: see %s\n", result.MergeConflicts, result.ConflictReport)
+			}
+			fmt.Println()
+		}
+		if result.TranslationsCarried > 0 {
+			fmt.Printf("♻️  Carried %d unchanged translations across from the previous translation\n", result.TranslationsCarried)
+			if len(result.TasksGenerated) == 0 && !dryRun {
+				fmt.Println("✓ Nothing new to translate")
+			}
+			fmt.Println()
+		}
+
+		// Step 7d: Show generated tasks
 		if len(result.TasksGenerated) > 0 && !dryRun {
 			for _, taskFile := range result.TasksGenerated {
 				fmt.Printf("✓ Generated %s with translation instructions\n", taskFile)
 			}
+			if result.MemoryExactMatches > 0 || result.MemoryFuzzyMatches > 0 {
+				fmt.Printf("🧠 Translation memory: %d pre-filled, %d fuzzy suggestions\n",
+					result.MemoryExactMatches, result.MemoryFuzzyMatches)
+			}
+			if result.GlossaryTermsSeeded > 0 {
+				fmt.Printf("📚 Glossary %s: %d new terms from drawing standards (translate with: mon-tool translate glossary add)\n",
+					target.Glossary, result.GlossaryTermsSeeded)
+			}
 			fmt.Println()
 			fmt.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
 			fmt.Println("📝 Translation task ready")
@@ -182,7 +400,7 @@ func handleTranslateSync(dryRun bool) {
 
 // handleTranslateApply handles the apply subcommand using CQRS pattern
 // VISIBLE CALL FLOW - following ADR 004 + CQRS pattern
-func handleTranslateApply(taskFile string, dryRun bool) {
+func handleTranslateApply(taskFile string, dryRun bool, force bool, onlyApproved bool) {
 	// Step 1: Get working directory
 	rootDir, err := os.Getwd()
 	if err != nil {
@@ -209,37 +427,56 @@ func handleTranslateApply(taskFile string, dryRun bool) {
 		}
 	}()
 
-	// Step 4: Create COMMAND object (intent to apply translations)
+	// Step 4: Load translation memory (applied translations feed back into it)
+	memory, err := translate.LoadMemory(rootDir, config.Paths.Events)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to load translation memory: %v\n", err)
+		memory = nil // Continue without translation memory
+	}
+
+	// Step 5: Create COMMAND object (intent to apply translations)
 	cmd := &commands.ApplyCommand{
-		RootDir:  rootDir,
-		TaskFile: taskFile,
-		DryRun:   dryRun,
+		RootDir:      rootDir,
+		TaskFile:     taskFile,
+		DryRun:       dryRun,
+		Force:        force,
+		OnlyApproved: onlyApproved,
 	}
 
-	// Step 5: Create command handler with event store
-	applyHandler := commands.NewApplyHandler(eventStore)
+	// Step 6: Create command handler with event store and memory
+	applyHandler := commands.NewApplyHandler(eventStore, memory)
 
-	// Step 6: Print header
+	// Step 7: Print header
 	fmt.Printf("\n━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
 	fmt.Printf("🌐 Applying translations from task file\n")
 	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n\n")
 
 	if dryRun {
-		fmt.Println("🔍 DRY RUN - No changes will be made\n")
+		fmt.Print("🔍 DRY RUN - No changes will be made\n\n")
 	}
 
-	// Step 7: Execute COMMAND via handler
+	// Step 8: Execute COMMAND via handler
 	result, err := applyHandler.Handle(cmd)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error executing apply command: %v\n", err)
 		os.Exit(1)
 	}
 
-	// Step 8: Display results
+	// Step 9: Display results
 	percentage := (result.FilledExtractions * 100) / result.TotalExtractions
 	fmt.Printf("📊 Translation Progress: %d/%d (%d%%)\n\n",
 		result.FilledExtractions, result.TotalExtractions, percentage)
 
+	if result.QAErrors > 0 || result.QAWarnings > 0 {
+		fmt.Printf("🔎 QA: %d errors, %d warnings (details: mon-tool translate qa %s)\n\n",
+			result.QAErrors, result.QAWarnings, taskFile)
+	}
+
+	if result.HeldBack > 0 {
+		fmt.Printf("🔒 %d translations not approved yet are held back (mon-tool translate review %s)\n\n",
+			result.HeldBack, taskFile)
+	}
+
 	if result.FilledExtractions < result.TotalExtractions {
 		fmt.Printf("⚠️  Warning: Only %d of %d translations are filled in\n",
 			result.FilledExtractions, result.TotalExtractions)
@@ -248,8 +485,19 @@ func handleTranslateApply(taskFile string, dryRun bool) {
 
 	if !dryRun {
 		fmt.Println()
-		fmt.Printf("Summary: %d files processed, %d files skipped\n",
-			result.FilesProcessed, result.FilesSkipped)
+		fmt.Printf("Summary: %d files processed, %d files skipped, %d translations applied\n",
+			result.FilesProcessed, result.FilesSkipped, result.AppliedExtractions)
+		if result.MemoryEntriesAdded > 0 {
+			fmt.Printf("🧠 Translation memory updated (%d translations)\n", result.MemoryEntriesAdded)
+		}
+
+		if len(result.Unresolved) > 0 {
+			fmt.Printf("\n⚠️  %d translations could not be resolved (not applied):\n", len(result.Unresolved))
+			for _, u := range result.Unresolved {
+				fmt.Printf("   %s:%d %s\n", u.File, u.Line, u.ID)
+				fmt.Printf("      %q - %s\n", u.SourceText, u.Reason)
+			}
+		}
 
 		if result.TaskFileDeleted {
 			fmt.Printf("\n🗑️  Deleted task file: %s\n", taskFile)
@@ -260,6 +508,8 @@ func handleTranslateApply(taskFile string, dryRun bool) {
 				fmt.Println("   (Partial translations remain)")
 			} else if result.FilesSkipped > 0 {
 				fmt.Println("   (Some files had errors)")
+			} else if len(result.Unresolved) > 0 {
+				fmt.Println("   (Some translations could not be resolved - re-run translate sync)")
 			}
 		}
 	} else {
@@ -338,8 +588,8 @@ func handleTranslateEvents() {
 			case "TaskGenerated":
 				var e events.TaskGenerated
 				if err := record.Unmarshal(&e); err == nil {
-					fmt.Printf("[%s] ✨ Generated task: %s (%d extractions for %s)\n",
-						timestamp, e.TaskFile, e.ExtractionCount, e.TargetLanguage)
+					fmt.Printf("[%s] ✨ Generated task: %s (%d extractions for %s, %d from memory)\n",
+						timestamp, e.TaskFile, e.ExtractionCount, e.TargetLanguage, e.PrefilledCount)
 				}
 			case "TaskLoaded":
 				var e events.TaskLoaded
@@ -353,10 +603,64 @@ func handleTranslateEvents() {
 					fmt.Printf("[%s] ✅ Applied translations: %s (%d applied, %d skipped)\n",
 						timestamp, e.FilePath, e.AppliedCount, e.SkippedCount)
 				}
+			case "TargetMerged":
+				var e events.TargetMerged
+				if err := record.Unmarshal(&e); err == nil {
+					if e.ConflictCount > 0 {
+						fmt.Printf("[%s] ⚠️  Merged hand edits: %s (%d conflicts, see %s)\n",
+							timestamp, e.FilePath, e.ConflictCount, e.ConflictReport)
+					} else {
+						fmt.Printf("[%s] ✍️  Merged hand edits: %s\n", timestamp, e.FilePath)
+					}
+				}
+			case "TranslationsCarried":
+				var e events.TranslationsCarried
+				if err := record.Unmarshal(&e); err == nil {
+					fmt.Printf("[%s] ♻️  Carried translations: %s (%d kept, %d changed)\n",
+						timestamp, e.FilePath, e.CarriedCount, e.ChangedCount)
+				}
 			case "TaskDeleted":
 				var e events.TaskDeleted
 				if err := record.Unmarshal(&e); err == nil {
-					fmt.Printf("[%s] 🎉 Completed: %s (task deleted)\n", timestamp, e.TaskFile)
+					if e.Reason == "translations_carried" {
+						fmt.Printf("[%s] ♻️  Removed %s (every translation carried across)\n", timestamp, e.TaskFile)
+					} else {
+						fmt.Printf("[%s] 🎉 Completed: %s (task deleted)\n", timestamp, e.TaskFile)
+					}
+				}
+			case "TaskExported":
+				var e events.TaskExported
+				if err := record.Unmarshal(&e); err == nil {
+					fmt.Printf("[%s] 📤 Exported task: %s → %s (%s, %d units)\n",
+						timestamp, e.TaskFile, e.OutputFile, e.Format, e.UnitCount)
+				}
+			case "TranslationsImported":
+				var e events.TranslationsImported
+				if err := record.Unmarshal(&e); err == nil {
+					fmt.Printf("[%s] 📥 Imported %s → %s (%d updated, %d untouched)\n",
+						timestamp, e.InputFile, e.TaskFile, e.UpdatedCount, e.UntouchedCount)
+				}
+			case "TaskChecked":
+				var e events.TaskChecked
+				if err := record.Unmarshal(&e); err == nil {
+					fmt.Printf("[%s] 🔎 QA checked %s (%d errors, %d warnings)\n",
+						timestamp, e.TaskFile, e.ErrorCount, e.WarningCount)
+				}
+			case "TextFitChecked":
+				var e events.TextFitChecked
+				if err := record.Unmarshal(&e); err == nil {
+					fmt.Printf("[%s] 📐 Text fit checked: %s (%d drawings, %d issues, %d fixed)\n",
+						timestamp, e.TargetLanguage, e.DrawingCount, e.IssueCount, e.FixedCount)
+				}
+			case "GlossarySeeded":
+				var e events.GlossarySeeded
+				if err := record.Unmarshal(&e); err == nil {
+					fmt.Printf("[%s] 📚 Seeded glossary: %s (%d terms)\n", timestamp, e.GlossaryFile, e.TermsAdded)
+				}
+			case "GlossaryTermAdded":
+				var e events.GlossaryTermAdded
+				if err := record.Unmarshal(&e); err == nil {
+					fmt.Printf("[%s] 📚 Glossary term: %s → %s (%s)\n", timestamp, e.Term, e.Translation, e.TargetLanguage)
 				}
 			case "AITranslationStarted":
 				var e events.AITranslationStarted
@@ -367,13 +671,69 @@ func handleTranslateEvents() {
 			case "AITranslationCompleted":
 				var e events.AITranslationCompleted
 				if err := record.Unmarshal(&e); err == nil {
-					fmt.Printf("[%s] ✅ AI Translation completed: %d items ($%.4f, %.1fs)\n",
-						timestamp, e.ItemsTranslated, e.CostUSD, e.DurationSeconds)
+					fmt.Printf("[%s] ✅ AI Translation completed: %d items, %d cached ($%.4f, %.1fs)\n",
+						timestamp, e.ItemsTranslated, e.CacheHits, e.CostUSD, e.DurationSeconds)
+				}
+			case "AIBatchTranslated":
+				var e events.AIBatchTranslated
+				if err := record.Unmarshal(&e); err == nil {
+					fmt.Printf("[%s] 🤖 AI batch %d/%d: %d/%d items, %d failed (%d in, %d out tokens)\n",
+						timestamp, e.Batch, e.BatchCount, e.ItemsTranslated, e.ItemsSent, e.ItemsFailed, e.InputTokens, e.OutputTokens)
+				}
+			case "AICachePruned":
+				var e events.AICachePruned
+				if err := record.Unmarshal(&e); err == nil {
+					fmt.Printf("[%s] 🗄️  AI cache pruned: %d removed, %d remaining\n", timestamp, e.RemovedCount, e.RemainingCount)
 				}
 			case "AITranslationFailed":
 				var e events.AITranslationFailed
 				if err := record.Unmarshal(&e); err == nil {
-					fmt.Printf("[%s] ❌ AI Translation failed: %s\n", timestamp, e.Error)
+					reason := e.Reason
+					if reason == "" {
+						reason = "error" // Events recorded before reasons were classified
+					}
+					fmt.Printf("[%s] ❌ AI Translation failed (%s): %s\n", timestamp, reason, e.Error)
+				}
+			case "TranslationVerified":
+				var e events.TranslationVerified
+				if err := record.Unmarshal(&e); err == nil {
+					fmt.Printf("[%s] 🔁 Verified %s: %d checked, %d flagged (avg drift %.0f%%, $%.4f)\n",
+						timestamp, e.TaskFile, e.ItemsChecked, e.ItemsFlagged, e.AverageDrift*100, e.CostUSD)
+				}
+			case "ExtractionApproved":
+				var e events.ExtractionApproved
+				if err := record.Unmarshal(&e); err == nil {
+					fmt.Printf("[%s] 👍 Approved %s %s (was %s, by %s)\n", timestamp, e.FilePath, e.ExtractionID, e.PreviousStatus, e.Reviewer)
+				}
+			case "ExtractionRejected":
+				var e events.ExtractionRejected
+				if err := record.Unmarshal(&e); err == nil {
+					fmt.Printf("[%s] 👎 Rejected %s %s (was %s, by %s): %q\n", timestamp, e.FilePath, e.ExtractionID, e.PreviousStatus, e.Reviewer, e.RejectedText)
+				}
+			case "PipelineStarted":
+				var e events.PipelineStarted
+				if err := record.Unmarshal(&e); err == nil {
+					if e.ResumePhase != "" {
+						fmt.Printf("[%s] 🚀 Pipeline %s resumed at %s\n", timestamp, e.TargetLanguage, e.ResumePhase)
+					} else {
+						fmt.Printf("[%s] 🚀 Pipeline %s started\n", timestamp, e.TargetLanguage)
+					}
+				}
+			case "PipelinePhaseCompleted":
+				var e events.PipelinePhaseCompleted
+				if err := record.Unmarshal(&e); err == nil {
+					fmt.Printf("[%s] ✓ Pipeline %s: %s done (%.1fs)\n", timestamp, e.TargetLanguage, e.Phase, e.DurationSeconds)
+				}
+			case "PipelineFailed":
+				var e events.PipelineFailed
+				if err := record.Unmarshal(&e); err == nil {
+					fmt.Printf("[%s] ❌ Pipeline %s failed at %s: %s\n", timestamp, e.TargetLanguage, e.Phase, e.Error)
+				}
+			case "PipelineCompleted":
+				var e events.PipelineCompleted
+				if err := record.Unmarshal(&e); err == nil {
+					fmt.Printf("[%s] ✅ Pipeline %s completed: %d translated, %d applied ($%.4f, %.1fs)\n",
+						timestamp, e.TargetLanguage, e.ItemsTranslated, e.ItemsApplied, e.CostUSD, e.DurationSeconds)
 				}
 			default:
 				fmt.Printf("[%s] %s\n", timestamp, record.Type)
@@ -386,9 +746,77 @@ func handleTranslateEvents() {
 	fmt.Printf("Total: %d events across %d sessions\n", len(records), len(sessions))
 }
 
+// autoFlags are the translate auto command-line overrides of the ai section of translate.json
+type autoFlags struct {
+	apiKey      string
+	provider    string
+	model       string
+	endpoint    string
+	workers     int
+	batchTokens int
+	noCache     bool
+}
+
+// parseAutoFlags parses the translate auto flags (also accepted by translate full)
+func parseAutoFlags(args []string) autoFlags {
+	var flags autoFlags // Zero values = from translate.json
+	for _, arg := range args {
+		switch {
+		case strings.HasPrefix(arg, "--api-key="):
+			flags.apiKey = strings.TrimPrefix(arg, "--api-key=")
+		case strings.HasPrefix(arg, "--provider="):
+			flags.provider = strings.TrimPrefix(arg, "--provider=")
+		case strings.HasPrefix(arg, "--model="):
+			flags.model = strings.TrimPrefix(arg, "--model=")
+		case strings.HasPrefix(arg, "--endpoint="):
+			flags.endpoint = strings.TrimPrefix(arg, "--endpoint=")
+		case strings.HasPrefix(arg, "--workers="):
+			flags.workers = parsePositiveFlag(arg, "--workers=", "parallel requests, e.g. 4")
+		case strings.HasPrefix(arg, "--batch-tokens="):
+			flags.batchTokens = parsePositiveFlag(arg, "--batch-tokens=", "estimated tokens per request, e.g. 2500")
+		case arg == "--no-cache":
+			flags.noCache = true
+		}
+	}
+	return flags
+}
+
+// parsePositiveFlag parses a --name=N flag that must be a whole number of at least 1, exiting on anything else
+func parsePositiveFlag(arg, prefix, hint string) int {
+	value := strings.TrimPrefix(arg, prefix)
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 1 {
+		fmt.Fprintf(os.Stderr, "Error: invalid %s %q (%s)\n", strings.TrimSuffix(prefix, "="), value, hint)
+		os.Exit(1)
+	}
+	return n
+}
+
+// apply returns the ai section of translate.json with the command-line overrides applied
+func (flags autoFlags) apply(aiConfig translate.AIConfig) translate.AIConfig {
+	if flags.provider != "" {
+		aiConfig.Provider = flags.provider
+		aiConfig.Model, aiConfig.Endpoint = "", "" // Model and endpoint belong to the configured provider
+	}
+	if flags.model != "" {
+		aiConfig.Model = flags.model
+	}
+	if flags.endpoint != "" {
+		aiConfig.Endpoint = flags.endpoint
+	}
+	aiConfig.APIKey = flags.apiKey
+	if flags.workers > 0 {
+		aiConfig.Workers = flags.workers
+	}
+	if flags.batchTokens > 0 {
+		aiConfig.BatchTokens = flags.batchTokens
+	}
+	return aiConfig
+}
+
 // handleTranslateAuto handles the auto subcommand using AI translation (HEADLESS mode)
 // VISIBLE CALL FLOW - following ADR 005 Headless AI Translation
-func handleTranslateAuto(taskFile string, apiKey string) {
+func handleTranslateAuto(taskFile string, flags autoFlags) {
 	// Step 1: Get working directory
 	rootDir, err := os.Getwd()
 	if err != nil {
@@ -420,20 +848,38 @@ func handleTranslateAuto(taskFile string, apiKey string) {
 	fmt.Printf("🤖 AI Translation (Headless Mode)\n")
 	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n\n")
 
-	// Step 5: Check API key
-	if apiKey == "" {
-		fmt.Fprintf(os.Stderr, "Error: ANTHROPIC_API_KEY not set\n\n")
+	// Step 5: Apply command-line overrides to the provider configuration
+	aiConfig := flags.apply(config.AI)
+
+	// Step 6: Create AI translator (provider from translate.json)
+	translator, err := translate.NewTranslator(rootDir, aiConfig)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n\n", err)
 		fmt.Println("Set your API key:")
 		fmt.Println("  export ANTHROPIC_API_KEY=sk-ant-...")
 		fmt.Println("Or pass it directly:")
 		fmt.Println("  mon-tool translate auto tasks/translate-th.json --api-key=sk-ant-...")
+		fmt.Println("Or pick another provider in code/translate.json (\"ai\": {\"provider\": ...}) or with --provider=")
 		os.Exit(1)
 	}
+	fmt.Printf("Using model: %s (%s)\n", translator.Name(), aiConfig.Provider)
+	fmt.Printf("Task file: %s\n", taskFile)
 
-	// Step 6: Create AI translator
-	translator := ai.NewClaudeTranslator(apiKey, "")
-	fmt.Printf("Using model: %s\n", translator.Name())
-	fmt.Printf("Task file: %s\n\n", taskFile)
+	// Step 7: Load glossary of the task's target language (terminology for the AI)
+	var glossary *translate.Glossary
+	targetLanguage := ""
+	if task, err := translate.LoadTask(rootDir, taskFile); err == nil {
+		targetLanguage = task.TargetLanguage
+		if target, ok := config.Target(task.TargetLanguage); ok {
+			glossary, err = translate.LoadGlossary(rootDir, config, target)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: failed to load glossary: %v\n", err)
+			} else {
+				fmt.Printf("Glossary: %s (%d translated terms)\n", target.Glossary, len(glossary.Terminology()))
+			}
+		}
+	}
+	fmt.Println()
 
 	// Emit AITranslationStarted event
 	startTime := time.Now()
@@ -450,64 +896,356 @@ func handleTranslateAuto(taskFile string, apiKey string) {
 		})
 	}
 
-	// Step 7: Call AutoTranslate (HEADLESS - no human interaction)
-	fmt.Println("🔄 Calling Claude API...")
-	task, response, err := translate.AutoTranslate(rootDir, taskFile, translator)
-	if err != nil {
-		// Emit AITranslationFailed event
-		if eventStore != nil {
-			eventStore.Append(&events.AITranslationFailed{
-				BaseEvent: events.BaseEvent{
-					Type:      "AITranslationFailed",
-					Occurred:  time.Now(),
-					SessionID: eventStore.SessionID(),
-				},
-				TaskFile: taskFile,
-				Error:    err.Error(),
-				Model:    translator.Name(),
-			})
+	// Step 8: Load the AI response cache (skipped with --no-cache)
+	var cache *translate.AICache
+	if !flags.noCache {
+		cache, err = translate.LoadAICache(rootDir, config.Paths.Events)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: failed to load AI cache: %v\n", err)
+			cache = nil
 		}
+	}
+
+	// Step 9: Work out the budget of this run (per-run cap, and what is left of the
+	// monthly cap after the spending recorded in the event log)
+	spending, err := translate.LoadSpending(rootDir, config.Paths.Events)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to read spending from events: %v\n", err)
+	}
+	budget, capped, err := translate.BudgetLimit(aiConfig.Budget, spending, time.Now())
+	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		fmt.Println("Raise ai.budget.per_month_usd in code/translate.json or wait for next month")
+		os.Exit(1)
+	}
+	if capped {
+		fmt.Printf("Budget: $%.4f for this run\n", budget)
+	}
+
+	// Step 10: Call AutoTranslate in batches (HEADLESS - no human interaction)
+	options := translate.AutoOptions{
+		BatchTokens: aiConfig.BatchTokens,
+		Workers:     aiConfig.Workers,
+		Cache:       cache,
+		Provider:    aiConfig.Provider,
+		Pricing:     aiConfig.Pricing,
+		BudgetUSD:   budget,
+		Preflight: func(estimate translate.AutoEstimate) error {
+			fmt.Printf("📋 Estimate: %d items in %d batches (%d cached), ~%d input + ~%d output tokens",
+				estimate.Items, estimate.Batches, estimate.CacheHits, estimate.InputTokens, estimate.OutputTokens)
+			if estimate.Priced {
+				fmt.Printf(", ~$%.4f\n", estimate.CostUSD)
+			} else {
+				fmt.Printf(", cost unknown (no price for %s in ai.pricing)\n", translator.Name())
+				if capped {
+					fmt.Fprintf(os.Stderr, "Warning: budget cannot be enforced without a price for %s\n", translator.Name())
+				}
+			}
+			return nil
+		},
+		OnBatch: func(batch translate.BatchResult) {
+			if batch.Err != nil {
+				fmt.Printf("  ❌ Batch %d/%d (%d items): %v\n", batch.Index, batch.Total, batch.Items, batch.Err)
+				return
+			}
+			mark := "✓"
+			if len(batch.Failures) > 0 {
+				mark = "⚠"
+			}
+			fmt.Printf("  %s Batch %d/%d: %d/%d items (%d tokens, %d retries)\n",
+				mark, batch.Index, batch.Total, batch.Translated, batch.Items, batch.Usage.TotalTokens, batch.Retries)
+			appendBatchEvent(eventStore, taskFile, batch)
+		},
+	}
+	fmt.Printf("🔄 Calling %s (%d workers, ~%d output tokens per batch)...\n", aiConfig.Provider, options.Workers, options.BatchTokens)
+	// Ctrl-C (or SIGTERM) cancels the requests in flight instead of killing the process,
+	// so finished batches stay saved and the failure is recorded
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	task, response, err := translate.AutoTranslate(ctx, rootDir, taskFile, translator, glossary, options)
+	stop()
+	if err != nil {
+		reason := ai.FailureReason(err)
+		if reason == "interrupted" {
+			fmt.Fprintf(os.Stderr, "\n⚠️  Interrupted\n")
+		}
+		// Emit AITranslationFailed event (with what the finished batches cost)
+		appendAutoFailed(eventStore, taskFile, targetLanguage, translator.Name(), err, response)
+		fmt.Fprintf(os.Stderr, "Error (%s): %v\n", reason, err)
+		if response != nil && response.ItemsProcessed > 0 {
+			fmt.Fprintf(os.Stderr, "Finished batches were saved to %s; re-run to translate the rest\n", taskFile)
+			fmt.Fprintf(os.Stderr, "(%d translated, %d failed, %d skipped)\n",
+				response.ItemsProcessed, response.ItemsFailed, response.ItemsSkipped)
+		}
 		os.Exit(1)
 	}
 
 	duration := time.Since(startTime).Seconds()
 
-	// Step 8: Save updated task file
+	// Step 11: Save updated task file (already saved per batch; final write for safety)
 	if err := translate.SaveTask(rootDir, taskFile, task); err != nil {
 		fmt.Fprintf(os.Stderr, "Error saving task file: %v\n", err)
 		os.Exit(1)
 	}
 
 	// Emit AITranslationCompleted event
-	if eventStore != nil {
-		eventStore.Append(&events.AITranslationCompleted{
-			BaseEvent: events.BaseEvent{
-				Type:      "AITranslationCompleted",
-				Occurred:  time.Now(),
-				SessionID: eventStore.SessionID(),
-			},
-			TaskFile:        taskFile,
-			ItemsTranslated: response.ItemsProcessed,
-			InputTokens:     response.Usage.InputTokens,
-			OutputTokens:    response.Usage.OutputTokens,
-			CostUSD:         response.Usage.EstimatedCost,
-			DurationSeconds: duration,
-			Model:           translator.Name(),
-		})
-	}
+	appendAutoCompleted(eventStore, taskFile, targetLanguage, translator.Name(), response, duration)
 
-	// Step 9: Display results
+	// Step 12: Display results
 	fmt.Printf("✅ Translation completed!\n\n")
 	fmt.Printf("📊 Statistics:\n")
 	fmt.Printf("  Items translated: %d\n", response.ItemsProcessed)
+	fmt.Printf("  Items failed:     %d\n", response.ItemsFailed)
+	fmt.Printf("  Items skipped:    %d\n", response.ItemsSkipped)
+	fmt.Printf("  Cache hits:       %d\n", response.CacheHits)
 	fmt.Printf("  Input tokens:     %d\n", response.Usage.InputTokens)
 	fmt.Printf("  Output tokens:    %d\n", response.Usage.OutputTokens)
 	fmt.Printf("  Total tokens:     %d\n", response.Usage.TotalTokens)
 	fmt.Printf("  Duration:         %.2fs\n", duration)
 	fmt.Printf("  Cost:             $%.4f\n\n", response.Usage.EstimatedCost)
 
+	if len(response.Failures) > 0 {
+		fmt.Printf("⚠️  %d items had no valid translation after retries (left empty):\n", len(response.Failures))
+		for i, failure := range response.Failures {
+			if i == 10 {
+				fmt.Printf("  ... and %d more\n", len(response.Failures)-10)
+				break
+			}
+			fmt.Printf("  %q: %s\n", failure.SourceText, failure.Reason)
+		}
+		fmt.Printf("Re-run translate auto to try them again.\n\n")
+	}
+
 	fmt.Printf("✓ Updated task file: %s\n\n", taskFile)
 	fmt.Println("Next step:")
 	fmt.Printf("  mon-tool translate apply %s\n", taskFile)
 }
+
+// appendBatchEvent records a batch merged by translate auto
+func appendBatchEvent(eventStore *events.Store, taskFile string, batch translate.BatchResult) {
+	if eventStore == nil {
+		return
+	}
+	eventStore.Append(&events.AIBatchTranslated{
+		BaseEvent: events.BaseEvent{
+			Type:      "AIBatchTranslated",
+			Occurred:  time.Now(),
+			SessionID: eventStore.SessionID(),
+		},
+		TaskFile:        taskFile,
+		Batch:           batch.Index,
+		BatchCount:      batch.Total,
+		ItemsSent:       batch.Items,
+		ItemsTranslated: batch.Translated,
+		ItemsFailed:     len(batch.Failures),
+		Retries:         batch.Retries,
+		InputTokens:     batch.Usage.InputTokens,
+		OutputTokens:    batch.Usage.OutputTokens,
+		CostUSD:         batch.Usage.EstimatedCost,
+	})
+}
+
+// appendAutoFailed records a failed translate auto run with what its finished batches cost
+// response may be nil (failed before any batch)
+func appendAutoFailed(eventStore *events.Store, taskFile, targetLanguage, model string, err error, response *ai.TranslationResponse) {
+	if eventStore == nil {
+		return
+	}
+	failed := &events.AITranslationFailed{
+		BaseEvent: events.BaseEvent{
+			Type:      "AITranslationFailed",
+			Occurred:  time.Now(),
+			SessionID: eventStore.SessionID(),
+		},
+		TaskFile:       taskFile,
+		TargetLanguage: targetLanguage,
+		Error:          err.Error(),
+		Reason:         ai.FailureReason(err),
+		Model:          model,
+	}
+	if response != nil {
+		failed.ItemsTranslated = response.ItemsProcessed
+		failed.InputTokens = response.Usage.InputTokens
+		failed.OutputTokens = response.Usage.OutputTokens
+		failed.CostUSD = response.Usage.EstimatedCost
+	}
+	eventStore.Append(failed)
+}
+
+// appendAutoCompleted records a finished translate auto run
+func appendAutoCompleted(eventStore *events.Store, taskFile, targetLanguage, model string, response *ai.TranslationResponse, duration float64) {
+	if eventStore == nil {
+		return
+	}
+	eventStore.Append(&events.AITranslationCompleted{
+		BaseEvent: events.BaseEvent{
+			Type:      "AITranslationCompleted",
+			Occurred:  time.Now(),
+			SessionID: eventStore.SessionID(),
+		},
+		TaskFile:        taskFile,
+		TargetLanguage:  targetLanguage,
+		ItemsTranslated: response.ItemsProcessed,
+		ItemsFailed:     response.ItemsFailed,
+		CacheHits:       response.CacheHits,
+		InputTokens:     response.Usage.InputTokens,
+		OutputTokens:    response.Usage.OutputTokens,
+		CostUSD:         response.Usage.EstimatedCost,
+		DurationSeconds: duration,
+		Model:           model,
+	})
+}
+
+// handleTranslateExport handles the export subcommand (task → CAT tool exchange file)
+// VISIBLE CALL FLOW - following ADR 004
+func handleTranslateExport(taskFile string, format string, output string) {
+	// Step 1: Get working directory
+	rootDir, err := os.Getwd()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error getting current directory: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Step 2: Load configuration (need events path)
+	config, err := translate.LoadConfig(rootDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading configuration: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Step 3: Load task file (QUERY - read only)
+	task, err := translate.LoadTask(rootDir, taskFile)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading task: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Step 4: Render exchange file
+	data, err := translate.ExportTask(task, format)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error exporting task: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Step 5: Write exchange file (default: next to the task file)
+	if output == "" {
+		output = strings.TrimSuffix(taskFile, filepath.Ext(taskFile)) + translate.ExchangeFileExtension(format)
+	}
+	if err := os.WriteFile(filepath.Join(rootDir, output), data, 0644); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing %s: %v\n", output, err)
+		os.Exit(1)
+	}
+
+	// Step 6: Emit TaskExported event
+	progress := translate.GetTranslationProgress(task)
+	if eventStore, err := events.NewStore(rootDir, config.Paths.Events); err == nil {
+		eventStore.Append(&events.TaskExported{
+			BaseEvent: events.BaseEvent{
+				Type:      "TaskExported",
+				Occurred:  time.Now(),
+				SessionID: eventStore.SessionID(),
+			},
+			TaskFile:   taskFile,
+			OutputFile: output,
+			Format:     format,
+			UnitCount:  progress.TotalExtractions,
+		})
+		eventStore.Close()
+	}
+
+	// Step 7: Display results
+	fmt.Printf("✓ Exported %s → %s (%s, %d units, %d already translated)\n",
+		taskFile, output, format, progress.TotalExtractions, progress.FilledExtractions)
+	fmt.Println()
+	fmt.Println("Next step:")
+	fmt.Printf("  mon-tool translate import %s\n", output)
+}
+
+// handleTranslateImport handles the import subcommand (CAT tool exchange file → task)
+// VISIBLE CALL FLOW - following ADR 004
+func handleTranslateImport(inputFile string, taskFile string) {
+	// Step 1: Get working directory
+	rootDir, err := os.Getwd()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error getting current directory: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Step 2: Load configuration (need events path)
+	config, err := translate.LoadConfig(rootDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading configuration: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Step 3: Determine format and task file (default: task next to the exchange file)
+	format, err := translate.ExchangeFormatFromPath(inputFile)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	if taskFile == "" {
+		taskFile = strings.TrimSuffix(inputFile, filepath.Ext(inputFile)) + ".json"
+	}
+
+	// Step 4: Load task file and exchange file (QUERY - read only)
+	task, err := translate.LoadTask(rootDir, taskFile)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading task: %v\n", err)
+		os.Exit(1)
+	}
+	data, err := os.ReadFile(filepath.Join(rootDir, inputFile))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", inputFile, err)
+		os.Exit(1)
+	}
+
+	// Step 5: Merge translations into the task
+	stats, err := translate.ImportTranslations(task, data, format)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error importing translations: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Step 6: Save updated task file
+	if err := translate.SaveTask(rootDir, taskFile, task); err != nil {
+		fmt.Fprintf(os.Stderr, "Error saving task file: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Step 7: Emit TranslationsImported event
+	if eventStore, err := events.NewStore(rootDir, config.Paths.Events); err == nil {
+		eventStore.Append(&events.TranslationsImported{
+			BaseEvent: events.BaseEvent{
+				Type:      "TranslationsImported",
+				Occurred:  time.Now(),
+				SessionID: eventStore.SessionID(),
+			},
+			InputFile:      inputFile,
+			TaskFile:       taskFile,
+			Format:         format,
+			UpdatedCount:   stats.Updated,
+			UntouchedCount: stats.Untouched,
+			StaleCount:     stats.Stale,
+			UnknownCount:   stats.Unknown,
+		})
+		eventStore.Close()
+	}
+
+	// Step 8: Display results
+	fmt.Printf("✓ Imported %s → %s\n\n", inputFile, taskFile)
+	fmt.Printf("📊 Import Summary:\n")
+	fmt.Printf("  Units read:      %d\n", stats.Units)
+	fmt.Printf("  Updated:         %d\n", stats.Updated)
+	fmt.Printf("  Unchanged:       %d\n", stats.Unchanged)
+	fmt.Printf("  Left empty:      %d (task value kept)\n", stats.Untouched)
+	if stats.Stale > 0 {
+		fmt.Printf("  ⚠️  Stale:        %d (source text changed since export, skipped)\n", stats.Stale)
+	}
+	if stats.Unknown > 0 {
+		fmt.Printf("  ⚠️  Unknown:      %d (no matching extraction in task, skipped)\n", stats.Unknown)
+	}
+	fmt.Println()
+	fmt.Println("Next step:")
+	fmt.Printf("  mon-tool translate apply %s\n", taskFile)
+}
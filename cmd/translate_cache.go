@@ -0,0 +1,163 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joeblew999/mon-house/pkg/translate"
+	"github.com/joeblew999/mon-house/pkg/translate/ai"
+	"github.com/joeblew999/mon-house/pkg/translate/events"
+)
+
+// handleTranslateCache handles the cache subcommand (stats, prune)
+// VISIBLE CALL FLOW - following ADR 004
+func handleTranslateCache(args []string) {
+	if len(args) == 0 {
+		printCacheUsage()
+		os.Exit(1)
+	}
+
+	// Parse flags
+	olderThan := 90 * 24 * time.Hour
+	all := false
+	for _, arg := range args[1:] {
+		switch {
+		case strings.HasPrefix(arg, "--older-than="):
+			age, err := parseAge(strings.TrimPrefix(arg, "--older-than="))
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+			olderThan = age
+		case arg == "--all":
+			all = true
+		}
+	}
+
+	// Step 1: Get working directory
+	rootDir, err := os.Getwd()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error getting current directory: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Step 2: Load configuration (cache lives next to the events)
+	config, err := translate.LoadConfig(rootDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading configuration: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Step 3: Load the AI cache (QUERY - read only)
+	cache, err := translate.LoadAICache(rootDir, config.Paths.Events)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Step 4: Run the cache action
+	switch args[0] {
+	case "stats":
+		cacheStats(cache)
+	case "prune":
+		cachePrune(rootDir, config, cache, olderThan, all)
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown cache action: %s\n\n", args[0])
+		printCacheUsage()
+		os.Exit(1)
+	}
+}
+
+func printCacheUsage() {
+	fmt.Println("AI Cache Commands:")
+	fmt.Println("  translate cache stats                       Show cached AI responses")
+	fmt.Println("  translate cache prune [--older-than=90d]    Drop entries unused for a while (and old prompt versions)")
+	fmt.Println("  translate cache prune --all                 Empty the cache")
+	fmt.Println()
+	fmt.Println("translate auto fills items from the cache before calling the provider (--no-cache to skip).")
+}
+
+// cacheStats prints the size and makeup of the cache
+func cacheStats(cache *translate.AICache) {
+	stats := cache.Stats()
+
+	fmt.Printf("\n🗄️  AI cache: %s\n", cache.Path())
+	fmt.Println("─────────────────────────────────────────────")
+	fmt.Printf("  Entries:   %d (%d from older prompt versions)\n", stats.Entries, stats.Stale)
+	fmt.Printf("  Size:      %.1f KB\n", float64(stats.SizeBytes)/1024)
+	if stats.Entries > 0 {
+		fmt.Printf("  Last used: %s … %s\n", stats.Oldest.Format("2006-01-02"), stats.Newest.Format("2006-01-02"))
+	}
+	fmt.Printf("  Prompt:    version %d\n", ai.PromptVersion)
+
+	printCounts("By model", stats.ByModel)
+	printCounts("By language", stats.ByLanguage)
+	fmt.Println()
+}
+
+// printCounts prints a labelled count map in key order
+func printCounts(label string, counts map[string]int) {
+	if len(counts) == 0 {
+		return
+	}
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	fmt.Printf("\n  %s:\n", label)
+	for _, key := range keys {
+		fmt.Printf("    %-36s %d\n", key, counts[key])
+	}
+}
+
+// cachePrune removes old entries and records an AICachePruned event
+func cachePrune(rootDir string, config *translate.Config, cache *translate.AICache, olderThan time.Duration, all bool) {
+	var removed int
+	if all {
+		removed = cache.Clear()
+	} else {
+		removed = cache.Prune(olderThan)
+	}
+	if err := cache.Save(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	remaining := cache.Stats().Entries
+
+	if eventStore, err := events.NewStore(rootDir, config.Paths.Events); err == nil {
+		eventStore.Append(&events.AICachePruned{
+			BaseEvent: events.BaseEvent{
+				Type:      "AICachePruned",
+				Occurred:  time.Now(),
+				SessionID: eventStore.SessionID(),
+			},
+			RemovedCount:   removed,
+			RemainingCount: remaining,
+		})
+		eventStore.Close()
+	}
+
+	fmt.Printf("✓ Pruned %d cache entries (%d remaining)\n", removed, remaining)
+}
+
+// parseAge parses a duration with a day suffix as well as Go units ("30d", "12h")
+func parseAge(value string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("invalid age %q (use e.g. 30d or 12h)", value)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	age, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid age %q (use e.g. 30d or 12h)", value)
+	}
+	return age, nil
+}
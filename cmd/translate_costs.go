@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/joeblew999/mon-house/pkg/translate"
+)
+
+// handleTranslateCosts handles the costs subcommand: AI spending from the event log
+// VISIBLE CALL FLOW - following ADR 004
+func handleTranslateCosts() {
+	// Step 1: Get working directory
+	rootDir, err := os.Getwd()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error getting current directory: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Step 2: Load configuration (events path and budget)
+	config, err := translate.LoadConfig(rootDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading configuration: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Step 3: Read spending from the event log (QUERY - read only)
+	spending, err := translate.LoadSpending(rootDir, config.Paths.Events)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading events: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Step 4: Print header
+	fmt.Printf("\n━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+	fmt.Printf("💰 AI Translation Costs\n")
+	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+
+	if len(spending) == 0 {
+		fmt.Println("\nNo AI translation runs recorded yet.")
+		return
+	}
+
+	// Step 5: Print spending grouped by model, language and month
+	printSpendGroups("By model", translate.GroupSpending(spending, func(r translate.SpendRecord) string { return r.Model }))
+	printSpendGroups("By language", translate.GroupSpending(spending, func(r translate.SpendRecord) string { return r.Language }))
+	printSpendGroups("By month", translate.GroupSpending(spending, translate.SpendRecord.Month))
+
+	// Step 6: Print totals and budget
+	total := 0.0
+	for _, record := range spending {
+		total += record.CostUSD
+	}
+	thisMonth := translate.MonthSpend(spending, time.Now())
+	fmt.Printf("\nTotal: $%.4f across %d runs\n", total, len(spending))
+	budget := config.AI.Budget
+	if budget.PerMonthUSD > 0 {
+		fmt.Printf("This month: $%.4f of $%.2f (%.0f%%)\n", thisMonth, budget.PerMonthUSD, thisMonth*100/budget.PerMonthUSD)
+	} else {
+		fmt.Printf("This month: $%.4f (no monthly budget)\n", thisMonth)
+	}
+	if budget.PerRunUSD > 0 {
+		fmt.Printf("Per run cap: $%.2f\n", budget.PerRunUSD)
+	}
+	fmt.Println()
+}
+
+// printSpendGroups prints one grouping of the spending as a table
+func printSpendGroups(label string, groups []translate.SpendGroup) {
+	fmt.Printf("\n%s:\n", label)
+	fmt.Printf("  %-32s %5s %7s %7s %10s %10s %10s\n", "", "Runs", "Items", "Cached", "In tok", "Out tok", "Cost")
+	for _, g := range groups {
+		fmt.Printf("  %-32s %5d %7d %7d %10d %10d %10s\n",
+			g.Key, g.Runs, g.Items, g.CacheHits, g.InputTokens, g.OutputTokens, fmt.Sprintf("$%.4f", g.CostUSD))
+	}
+}
@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/joeblew999/mon-house/pkg/translate"
+	"github.com/joeblew999/mon-house/pkg/translate/events"
+)
+
+// handleTranslateFit handles the fit subcommand (translated labels that outgrow their drawing)
+// VISIBLE CALL FLOW - following ADR 004
+func handleTranslateFit(language string, fix bool) {
+	// Step 1: Get working directory
+	rootDir, err := os.Getwd()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error getting current directory: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Step 2: Load configuration (folders and standards path)
+	config, err := translate.LoadConfig(rootDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading configuration: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Step 3: Load typography (fonts and permitted font sizes)
+	typography, err := translate.LoadTypography(filepath.Join(rootDir, config.Paths.Standards))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading typography: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Step 4: Select target languages (all unless --language is given)
+	targets := config.Targets
+	if language != "" {
+		target, ok := config.Target(language)
+		if !ok {
+			fmt.Fprintf(os.Stderr, "Error: target language %s not found in config\n", language)
+			os.Exit(1)
+		}
+		targets = []translate.TargetConfig{target}
+	}
+
+	// Step 5: Check each translated drawing against its source
+	unresolved := 0
+	for _, target := range targets {
+		pairs, err := translate.TranslatedDrawings(rootDir, config, target)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+
+		fmt.Printf("\n📐 Text fit: %s (%d drawings)\n", target.LanguageName, len(pairs))
+		fmt.Println("─────────────────────────────────────────────")
+
+		labels, issues, fixed := 0, 0, 0
+		for _, pair := range pairs {
+			result, err := translate.CheckTextFit(rootDir, pair, typography, fix)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+			printDrawingFit(result)
+			labels += result.Labels
+			issues += len(result.Issues)
+			fixed += result.Fixed
+			unresolved += result.Unresolved()
+		}
+
+		// Step 6: Emit TextFitChecked event
+		if eventStore, err := events.NewStore(rootDir, config.Paths.Events); err == nil {
+			eventStore.Append(&events.TextFitChecked{
+				BaseEvent: events.BaseEvent{
+					Type:      "TextFitChecked",
+					Occurred:  time.Now(),
+					SessionID: eventStore.SessionID(),
+				},
+				TargetLanguage: target.Language,
+				DrawingCount:   len(pairs),
+				LabelCount:     labels,
+				IssueCount:     issues,
+				FixedCount:     fixed,
+			})
+			eventStore.Close()
+		}
+	}
+
+	// Step 7: Display summary
+	fmt.Println()
+	if unresolved > 0 {
+		if fix {
+			fmt.Printf("❌ %d labels could not be fixed (shorten the translation or move the label)\n", unresolved)
+		} else {
+			fmt.Printf("❌ %d labels do not fit (fix with: mon-tool translate fit --fix)\n", unresolved)
+		}
+		os.Exit(1)
+	}
+	fmt.Println("✅ All translated labels fit")
+}
+
+// printDrawingFit prints the issues of one drawing
+func printDrawingFit(result *translate.DrawingFit) {
+	if len(result.Issues) == 0 {
+		fmt.Printf("✓ %s (%d labels)\n", result.Target, result.Labels)
+		return
+	}
+
+	fmt.Printf("⚠️  %s (%d labels, %d issues)\n", result.Target, result.Labels, len(result.Issues))
+	for _, issue := range result.Issues {
+		fmt.Printf("   %s %q: %s (%s)\n", issue.Path, issue.Text, issue.Kind, issue.With)
+		fmt.Printf("      width %.0f → %.0f at %gpx", issue.SourceWidth, issue.TargetWidth, issue.FontSize)
+		if issue.Fix != "" {
+			fmt.Printf(" (fixed: %s)", issue.Fix)
+		}
+		fmt.Println()
+	}
+}
@@ -0,0 +1,437 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/joeblew999/mon-house/pkg/translate"
+	"github.com/joeblew999/mon-house/pkg/translate/ai"
+	"github.com/joeblew999/mon-house/pkg/translate/commands"
+	"github.com/joeblew999/mon-house/pkg/translate/events"
+)
+
+// fullFlags are the translate full options (plus the translate auto overrides)
+type fullFlags struct {
+	language string // "" = every target in translate.json
+	restart  bool   // Ignore checkpoints and start again from sync
+	force    bool   // Apply even if QA finds errors
+	auto     autoFlags
+}
+
+// pipelineRun holds what every phase of translate full shares
+type pipelineRun struct {
+	rootDir    string
+	config     *translate.Config
+	aiConfig   translate.AIConfig
+	translator ai.Translator
+	eventStore *events.Store
+	memory     *translate.Memory
+	flags      fullFlags
+}
+
+// pipelineSummary is the outcome of translate full for one target language
+type pipelineSummary struct {
+	language    string
+	resumedAt   string // Phase the run resumed at ("" = fresh run)
+	taskFile    string
+	extractions int
+	prefilled   int // Filled by translation memory during sync
+	translated  int // Filled by the AI (including cache hits)
+	cacheHits   int
+	failed      int // Left empty by the AI
+	applied     int
+	costUSD     float64
+	duration    float64
+	taskDeleted bool
+	failedPhase string
+	err         error
+	nothingToDo bool // Sync found nothing to translate
+}
+
+// handleTranslateFull handles the full subcommand: sync, auto and apply in one go
+// VISIBLE CALL FLOW - following ADR 005 Headless AI Translation (fully automated pipeline)
+func handleTranslateFull(flags fullFlags) {
+	// Step 1: Get working directory
+	rootDir, err := os.Getwd()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error getting current directory: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Step 2: Load configuration
+	config, err := translate.LoadConfig(rootDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading configuration: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Step 3: Select the target languages (--language= or all of them)
+	targets := config.Targets
+	if flags.language != "" {
+		target, ok := config.Target(flags.language)
+		if !ok {
+			fmt.Fprintf(os.Stderr, "Error: target language %s not found in config\n", flags.language)
+			os.Exit(1)
+		}
+		targets = []translate.TargetConfig{target}
+	}
+
+	// Step 4: Create AI translator up front (fail before touching any files)
+	aiConfig := flags.auto.apply(config.AI)
+	translator, err := translate.NewTranslator(rootDir, aiConfig)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n\n", err)
+		fmt.Println("Set your API key (export ANTHROPIC_API_KEY=sk-ant-...), pass --api-key=,")
+		fmt.Println("or pick another provider with --provider=")
+		os.Exit(1)
+	}
+
+	// Step 5: Create event store (checkpoints live in the event log)
+	eventStore, err := events.NewStore(rootDir, config.Paths.Events)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to create event store: %v (runs cannot be resumed)\n", err)
+		eventStore = nil
+	}
+	defer func() {
+		if eventStore != nil {
+			eventStore.Close()
+		}
+	}()
+
+	// Step 6: Load translation memory (sync pre-fills from it, apply adds to it)
+	memory, err := translate.LoadMemory(rootDir, config.Paths.Events)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to load translation memory: %v\n", err)
+		memory = nil
+	}
+
+	// Step 7: Print header
+	fmt.Printf("\n━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+	fmt.Printf("🤖 Automated Translation Pipeline\n")
+	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+	fmt.Printf("Using model: %s (%s)\n", translator.Name(), aiConfig.Provider)
+
+	// Step 8: Run the phases of each language; Ctrl-C stops the AI requests in flight
+	// and the run resumes from the interrupted phase next time
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	run := &pipelineRun{
+		rootDir:    rootDir,
+		config:     config,
+		aiConfig:   aiConfig,
+		translator: translator,
+		eventStore: eventStore,
+		memory:     memory,
+		flags:      flags,
+	}
+	var summaries []*pipelineSummary
+	for _, target := range targets {
+		summary := run.language(ctx, target)
+		summaries = append(summaries, summary)
+		if ctx.Err() != nil {
+			break // Interrupted: leave the remaining languages for the next run
+		}
+	}
+	stop()
+
+	// Step 9: Print the combined summary
+	if !printPipelineSummary(summaries) {
+		os.Exit(1)
+	}
+}
+
+// language runs the pipeline of one target language, skipping the phases its
+// last unfinished run already completed (unless --restart)
+func (p *pipelineRun) language(ctx context.Context, target translate.TargetConfig) *pipelineSummary {
+	start := time.Now()
+	summary := &pipelineSummary{language: target.Language}
+
+	fmt.Printf("\n🌐 %s → %s (%s)\n", p.config.Source.Language, target.Language, target.Folder)
+
+	// Resume from the checkpoints in the event log
+	checkpoint := &translate.PipelineCheckpoint{TargetLanguage: target.Language, Done: make(map[string]bool)}
+	if !p.flags.restart && p.eventStore != nil {
+		loaded, err := translate.LoadPipelineCheckpoint(p.rootDir, p.config.Paths.Events, target.Language)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: failed to read checkpoints: %v (starting over)\n", err)
+		} else {
+			checkpoint = loaded
+		}
+	}
+	if checkpoint.Resuming() {
+		if _, err := os.Stat(filepath.Join(p.rootDir, checkpoint.TaskFile)); checkpoint.TaskFile != "" && err != nil {
+			fmt.Printf("⚠️  Task file %s of the unfinished run is gone, starting over\n", checkpoint.TaskFile)
+			checkpoint = &translate.PipelineCheckpoint{TargetLanguage: target.Language, Done: make(map[string]bool)}
+		} else {
+			summary.resumedAt = checkpoint.NextPhase()
+			summary.taskFile = checkpoint.TaskFile
+			fmt.Printf("↩️  Resuming at %s (previous run failed", summary.resumedAt)
+			if checkpoint.Error != "" {
+				fmt.Printf(": %s", checkpoint.Error)
+			}
+			fmt.Println(")")
+		}
+	}
+	p.append(&events.PipelineStarted{
+		BaseEvent:      p.base("PipelineStarted"),
+		TargetLanguage: target.Language,
+		ResumePhase:    summary.resumedAt,
+	})
+
+	phases := []struct {
+		name  string
+		title string
+		run   func() error
+	}{
+		{translate.PhaseSync, "Phase 1: Extracting text...", func() error { return p.sync(target, summary) }},
+		{translate.PhaseAuto, "Phase 2: Translating with AI...", func() error { return p.auto(ctx, target, summary) }},
+		{translate.PhaseApply, "Phase 3: Applying translations...", func() error { return p.apply(summary) }},
+	}
+	for _, phase := range phases {
+		if checkpoint.Done[phase.name] {
+			fmt.Printf("%s ✓ done in the previous run\n", phase.title)
+			continue
+		}
+		fmt.Println(phase.title)
+		phaseStart := time.Now()
+		if err := phase.run(); err != nil {
+			summary.failedPhase, summary.err = phase.name, err
+			fmt.Fprintf(os.Stderr, "❌ %s failed: %v\n", phase.name, err)
+			p.append(&events.PipelineFailed{
+				BaseEvent:      p.base("PipelineFailed"),
+				TargetLanguage: target.Language,
+				Phase:          phase.name,
+				Error:          err.Error(),
+			})
+			summary.duration = time.Since(start).Seconds()
+			return summary
+		}
+		p.append(&events.PipelinePhaseCompleted{
+			BaseEvent:       p.base("PipelinePhaseCompleted"),
+			TargetLanguage:  target.Language,
+			Phase:           phase.name,
+			TaskFile:        summary.taskFile,
+			DurationSeconds: time.Since(phaseStart).Seconds(),
+		})
+	}
+
+	summary.duration = time.Since(start).Seconds()
+	p.append(&events.PipelineCompleted{
+		BaseEvent:       p.base("PipelineCompleted"),
+		TargetLanguage:  target.Language,
+		ItemsTranslated: summary.translated,
+		ItemsApplied:    summary.applied,
+		CostUSD:         summary.costUSD,
+		DurationSeconds: summary.duration,
+	})
+	return summary
+}
+
+// sync is phase 1: mirror the source folders and generate the task file
+func (p *pipelineRun) sync(target translate.TargetConfig, summary *pipelineSummary) error {
+	result, err := commands.NewSyncHandler(p.eventStore, p.memory).Handle(&commands.SyncCommand{
+		RootDir:    p.rootDir,
+		SourceLang: p.config.Source.Language,
+		TargetLang: target.Language,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(result.TasksGenerated) == 0 {
+		summary.nothingToDo = true
+		fmt.Printf("✓ Synced (%d files copied), nothing to translate\n", result.FilesCopied)
+		return nil
+	}
+	summary.taskFile = result.TasksGenerated[0]
+	summary.prefilled = result.MemoryExactMatches
+
+	task, err := translate.LoadTask(p.rootDir, summary.taskFile)
+	if err != nil {
+		return err
+	}
+	summary.extractions = translate.ValidateTask(task).TotalExtractions
+	fmt.Printf("✓ Generated %s (%d extractions, %d from translation memory)\n",
+		summary.taskFile, summary.extractions, summary.prefilled)
+	return nil
+}
+
+// auto is phase 2: fill the empty extractions with the AI translator
+func (p *pipelineRun) auto(ctx context.Context, target translate.TargetConfig, summary *pipelineSummary) error {
+	if summary.taskFile == "" {
+		fmt.Println("✓ Nothing to translate")
+		return nil
+	}
+	task, err := translate.LoadTask(p.rootDir, summary.taskFile)
+	if err != nil {
+		return err
+	}
+	stats := translate.ValidateTask(task)
+	summary.extractions = stats.TotalExtractions
+	if stats.FilledExtractions == stats.TotalExtractions {
+		fmt.Println("✓ All extractions already filled")
+		return nil
+	}
+
+	// Glossary terminology, AI cache and budget, as for translate auto
+	glossary, err := translate.LoadGlossary(p.rootDir, p.config, target)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to load glossary: %v\n", err)
+		glossary = nil
+	}
+	var cache *translate.AICache
+	if !p.flags.auto.noCache {
+		cache, err = translate.LoadAICache(p.rootDir, p.config.Paths.Events)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: failed to load AI cache: %v\n", err)
+			cache = nil
+		}
+	}
+	spending, err := translate.LoadSpending(p.rootDir, p.config.Paths.Events)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to read spending from events: %v\n", err)
+	}
+	budget, _, err := translate.BudgetLimit(p.aiConfig.Budget, spending, time.Now())
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	p.append(&events.AITranslationStarted{
+		BaseEvent: p.base("AITranslationStarted"),
+		TaskFile:  summary.taskFile,
+		Model:     p.translator.Name(),
+	})
+	options := translate.AutoOptions{
+		BatchTokens: p.aiConfig.BatchTokens,
+		Workers:     p.aiConfig.Workers,
+		Cache:       cache,
+		Provider:    p.aiConfig.Provider,
+		Pricing:     p.aiConfig.Pricing,
+		BudgetUSD:   budget,
+		Preflight: func(estimate translate.AutoEstimate) error {
+			fmt.Printf("📋 Estimate: %d items in %d batches (%d cached), ~$%.4f\n",
+				estimate.Items, estimate.Batches, estimate.CacheHits, estimate.CostUSD)
+			return nil
+		},
+		OnBatch: func(batch translate.BatchResult) {
+			if batch.Err == nil {
+				appendBatchEvent(p.eventStore, summary.taskFile, batch)
+			}
+		},
+	}
+	task, response, err := translate.AutoTranslate(ctx, p.rootDir, summary.taskFile, p.translator, glossary, options)
+	if response != nil {
+		summary.translated = response.ItemsProcessed + response.CacheHits
+		summary.cacheHits = response.CacheHits
+		summary.failed = response.ItemsFailed
+		summary.costUSD = response.Usage.EstimatedCost
+	}
+	if err != nil {
+		appendAutoFailed(p.eventStore, summary.taskFile, target.Language, p.translator.Name(), err, response)
+		return fmt.Errorf("%s: %w", ai.FailureReason(err), err)
+	}
+	if err := translate.SaveTask(p.rootDir, summary.taskFile, task); err != nil {
+		return err
+	}
+	appendAutoCompleted(p.eventStore, summary.taskFile, target.Language, p.translator.Name(), response, time.Since(startTime).Seconds())
+
+	fmt.Printf("✓ Translated %d items (%d from cache, %d failed)\n", summary.translated, summary.cacheHits, summary.failed)
+	fmt.Printf("📊 Usage: %d input tokens, %d output tokens\n", response.Usage.InputTokens, response.Usage.OutputTokens)
+	fmt.Printf("💰 Cost: $%.4f\n", summary.costUSD)
+	return nil
+}
+
+// apply is phase 3: write the translations into the target files
+func (p *pipelineRun) apply(summary *pipelineSummary) error {
+	if summary.taskFile == "" {
+		fmt.Println("✓ Nothing to apply")
+		return nil
+	}
+	result, err := commands.NewApplyHandler(p.eventStore, p.memory).Handle(&commands.ApplyCommand{
+		RootDir:  p.rootDir,
+		TaskFile: summary.taskFile,
+		Force:    p.flags.force,
+	})
+	if err != nil {
+		return err
+	}
+
+	summary.extractions = result.TotalExtractions
+	summary.applied = result.AppliedExtractions
+	summary.taskDeleted = result.TaskFileDeleted
+	fmt.Printf("✓ Applied %d translations to %d files\n", result.AppliedExtractions, result.FilesProcessed)
+	if len(result.Unresolved) > 0 {
+		fmt.Printf("⚠️  %d translations could not be resolved (re-run translate sync)\n", len(result.Unresolved))
+	}
+	if result.TaskFileDeleted {
+		fmt.Println("🗑️  Deleted task file")
+	} else {
+		fmt.Printf("📝 Task file kept: %s (%d of %d filled)\n", summary.taskFile, result.FilledExtractions, result.TotalExtractions)
+	}
+	return nil
+}
+
+// base returns the BaseEvent of a new pipeline event
+func (p *pipelineRun) base(eventType string) events.BaseEvent {
+	base := events.BaseEvent{Type: eventType, Occurred: time.Now()}
+	if p.eventStore != nil {
+		base.SessionID = p.eventStore.SessionID()
+	}
+	return base
+}
+
+// append records an event if the event store is available
+func (p *pipelineRun) append(event events.Event) {
+	if p.eventStore != nil {
+		p.eventStore.Append(event)
+	}
+}
+
+// printPipelineSummary prints one line per language and the totals;
+// returns false if any language failed
+func printPipelineSummary(summaries []*pipelineSummary) bool {
+	fmt.Printf("\n━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+	fmt.Printf("  %-8s %-10s %11s %10s %7s %7s %8s %10s\n", "Language", "Status", "Extractions", "Translated", "Failed", "Applied", "Time", "Cost")
+
+	ok := true
+	var totalCost float64
+	var totalTranslated, totalApplied int
+	for _, s := range summaries {
+		status := "done"
+		switch {
+		case s.err != nil:
+			status = s.failedPhase + " ✗"
+			ok = false
+		case s.nothingToDo:
+			status = "up to date"
+		case s.resumedAt != "":
+			status = "resumed"
+		}
+		fmt.Printf("  %-8s %-10s %11d %10d %7d %7d %7.1fs %10s\n",
+			s.language, status, s.extractions, s.translated, s.failed, s.applied, s.duration, fmt.Sprintf("$%.4f", s.costUSD))
+		totalCost += s.costUSD
+		totalTranslated += s.translated
+		totalApplied += s.applied
+	}
+
+	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+	if ok {
+		fmt.Printf("✅ Translation complete! %d translated, %d applied, $%.4f\n", totalTranslated, totalApplied, totalCost)
+	} else {
+		fmt.Printf("❌ Some languages failed (%d translated, %d applied, $%.4f)\n", totalTranslated, totalApplied, totalCost)
+		for _, s := range summaries {
+			if s.err != nil {
+				fmt.Printf("   %s: %s phase: %v\n", s.language, s.failedPhase, s.err)
+			}
+		}
+		fmt.Println("Re-run translate full to resume at the failed phase (--restart to start over)")
+	}
+	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+	return ok
+}
@@ -0,0 +1,183 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/joeblew999/mon-house/pkg/translate"
+	"github.com/joeblew999/mon-house/pkg/translate/events"
+)
+
+// handleTranslateGlossary handles the glossary subcommand (list, add, check)
+// VISIBLE CALL FLOW - following ADR 004
+func handleTranslateGlossary(args []string) {
+	if len(args) == 0 {
+		printGlossaryUsage()
+		os.Exit(1)
+	}
+
+	// Parse flags and positional arguments
+	language := ""
+	note := ""
+	var positional []string
+	for _, arg := range args[1:] {
+		switch {
+		case strings.HasPrefix(arg, "--language="):
+			language = strings.TrimPrefix(arg, "--language=")
+		case strings.HasPrefix(arg, "--note="):
+			note = strings.TrimPrefix(arg, "--note=")
+		default:
+			positional = append(positional, arg)
+		}
+	}
+
+	// Step 1: Get working directory
+	rootDir, err := os.Getwd()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error getting current directory: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Step 2: Load configuration (targets and glossary paths)
+	config, err := translate.LoadConfig(rootDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading configuration: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Step 3: Select target languages (all unless --language is given)
+	targets := config.Targets
+	if language != "" {
+		target, ok := config.Target(language)
+		if !ok {
+			fmt.Fprintf(os.Stderr, "Error: target language %s not found in config\n", language)
+			os.Exit(1)
+		}
+		targets = []translate.TargetConfig{target}
+	}
+
+	// Step 4: Run the glossary action
+	switch args[0] {
+	case "list":
+		glossaryList(rootDir, config, targets)
+	case "add":
+		if len(positional) != 2 {
+			fmt.Fprintf(os.Stderr, "Error: glossary add requires a term and its translation\n\n")
+			printGlossaryUsage()
+			os.Exit(1)
+		}
+		if len(targets) != 1 {
+			fmt.Fprintf(os.Stderr, "Error: glossary add needs --language= (several targets configured)\n")
+			os.Exit(1)
+		}
+		glossaryAdd(rootDir, config, targets[0], positional[0], positional[1], note)
+	case "check":
+		glossaryCheck(rootDir, config, targets)
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown glossary action: %s\n\n", args[0])
+		printGlossaryUsage()
+		os.Exit(1)
+	}
+}
+
+func printGlossaryUsage() {
+	fmt.Println("Glossary Commands:")
+	fmt.Println("  translate glossary list [--language=th]          List terms and their translations")
+	fmt.Println("  translate glossary add <term> <translation> [--language=th] [--note=...]")
+	fmt.Println("                                                   Add or change a term translation")
+	fmt.Println("  translate glossary check [--language=th]         Flag applied translations that ignore a term")
+	fmt.Println()
+	fmt.Println("Glossaries are seeded from drawing-standards.json on every translate sync.")
+}
+
+// glossaryList prints the glossary of each target
+func glossaryList(rootDir string, config *translate.Config, targets []translate.TargetConfig) {
+	for _, target := range targets {
+		glossary, err := translate.LoadGlossary(rootDir, config, target)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+
+		translated := len(glossary.Terminology())
+		fmt.Printf("\n📚 %s (%s): %d terms, %d translated\n", target.Glossary, target.LanguageName, len(glossary.Terms), translated)
+		fmt.Println("─────────────────────────────────────────────")
+		for _, term := range glossary.Terms {
+			translation := term.Target
+			if translation == "" {
+				translation = "(untranslated)"
+			}
+			fmt.Printf("  %-28s → %s\n", term.Source, translation)
+		}
+	}
+}
+
+// glossaryAdd adds or changes one term translation and records a GlossaryTermAdded event
+func glossaryAdd(rootDir string, config *translate.Config, target translate.TargetConfig, term, translation, note string) {
+	glossary, err := translate.LoadGlossary(rootDir, config, target)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	glossary.Add(term, translation, note, "manual")
+	if err := glossary.Save(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	if eventStore, err := events.NewStore(rootDir, config.Paths.Events); err == nil {
+		eventStore.Append(&events.GlossaryTermAdded{
+			BaseEvent: events.BaseEvent{
+				Type:      "GlossaryTermAdded",
+				Occurred:  time.Now(),
+				SessionID: eventStore.SessionID(),
+			},
+			GlossaryFile:   target.Glossary,
+			TargetLanguage: target.Language,
+			Term:           term,
+			Translation:    translation,
+		})
+		eventStore.Close()
+	}
+
+	fmt.Printf("✓ %s: %s → %s\n", target.Glossary, term, translation)
+}
+
+// glossaryCheck checks the translation memory (every applied translation) against
+// each glossary and exits with status 1 if a term was not used
+func glossaryCheck(rootDir string, config *translate.Config, targets []translate.TargetConfig) {
+	memory, err := translate.LoadMemory(rootDir, config.Paths.Events)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading translation memory: %v\n", err)
+		os.Exit(1)
+	}
+
+	total := 0
+	for _, target := range targets {
+		glossary, err := translate.LoadGlossary(rootDir, config, target)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+
+		violations := translate.CheckGlossary(glossary, memory)
+		total += len(violations)
+
+		fmt.Printf("\n📚 %s (%s): %d applied translations checked against %d terms\n",
+			target.Glossary, target.LanguageName, len(memory.Entries(glossary.SourceLanguage, target.Language)), len(glossary.Terminology()))
+		for _, v := range violations {
+			fmt.Printf("  ⚠ %q should use %q for %q\n", v.SourceText, v.Term.Target, v.Term.Source)
+			fmt.Printf("    translation: %s\n", v.TargetText)
+		}
+	}
+
+	fmt.Println()
+	if total > 0 {
+		fmt.Printf("❌ %d translations ignore a glossary term\n", total)
+		os.Exit(1)
+	}
+	fmt.Println("✅ All applied translations follow the glossary")
+}
@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/joeblew999/mon-house/pkg/translate"
+	"github.com/joeblew999/mon-house/pkg/translate/events"
+)
+
+// handleTranslateQA handles the qa subcommand (task file, or applied translations if none given)
+// VISIBLE CALL FLOW - following ADR 004
+func handleTranslateQA(taskFile string) {
+	// Step 1: Get working directory
+	rootDir, err := os.Getwd()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error getting current directory: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Step 2: Load configuration (need events path)
+	config, err := translate.LoadConfig(rootDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading configuration: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Step 3: Run QA checks with each language's glossary (QUERY - read only)
+	var reports []*translate.QAReport
+	var titles []string
+	if taskFile != "" {
+		task, err := translate.LoadTask(rootDir, taskFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading task: %v\n", err)
+			os.Exit(1)
+		}
+		var glossary *translate.Glossary
+		if target, ok := config.Target(task.TargetLanguage); ok {
+			if glossary, err = translate.LoadGlossary(rootDir, config, target); err != nil {
+				fmt.Fprintf(os.Stderr, "Error loading glossary: %v\n", err)
+				os.Exit(1)
+			}
+		}
+		report := translate.CheckTask(task, glossary, config.DoNotTranslate)
+		reports = append(reports, report)
+		titles = append(titles, taskFile)
+
+		// Emit TaskChecked event
+		if eventStore, err := events.NewStore(rootDir, config.Paths.Events); err == nil {
+			eventStore.Append(&events.TaskChecked{
+				BaseEvent: events.BaseEvent{
+					Type:      "TaskChecked",
+					Occurred:  time.Now(),
+					SessionID: eventStore.SessionID(),
+				},
+				TaskFile:     taskFile,
+				CheckedCount: report.Checked,
+				ErrorCount:   report.Count(translate.QAError),
+				WarningCount: report.Count(translate.QAWarning),
+			})
+			eventStore.Close()
+		}
+	} else {
+		// No task: check the applied translations in the translation memory
+		memory, err := translate.LoadMemory(rootDir, config.Paths.Events)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading translation memory: %v\n", err)
+			os.Exit(1)
+		}
+		for _, target := range config.Targets {
+			glossary, err := translate.LoadGlossary(rootDir, config, target)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error loading glossary: %v\n", err)
+				os.Exit(1)
+			}
+			reports = append(reports, translate.CheckMemory(memory, config.Source.Language, target.Language, glossary, config.DoNotTranslate))
+			titles = append(titles, fmt.Sprintf("applied %s translations", target.LanguageName))
+		}
+	}
+
+	// Step 4: Display issues
+	errors, warnings := 0, 0
+	for i, report := range reports {
+		fmt.Printf("\n🔎 QA: %s (%d translations checked)\n", titles[i], report.Checked)
+		fmt.Println("─────────────────────────────────────────────")
+		for _, issue := range report.Issues {
+			icon := "⚠️ "
+			if issue.Severity == translate.QAError {
+				icon = "❌"
+			}
+			location := issue.File
+			if issue.Line > 0 {
+				location = fmt.Sprintf("%s:%d", issue.File, issue.Line)
+			}
+			fmt.Printf("%s %-7s %-9s %s\n", icon, issue.Severity, issue.Check, location)
+			fmt.Printf("   %s\n", issue.Message)
+			fmt.Printf("   source: %q\n", issue.SourceText)
+			if issue.TargetText != "" {
+				fmt.Printf("   target: %q\n", issue.TargetText)
+			}
+		}
+		errors += report.Count(translate.QAError)
+		warnings += report.Count(translate.QAWarning)
+	}
+
+	fmt.Println()
+	fmt.Printf("Summary: %d errors, %d warnings\n", errors, warnings)
+	if errors > 0 {
+		fmt.Println("❌ translate apply refuses tasks with QA errors (override with --force)")
+		os.Exit(1)
+	}
+	fmt.Println("✅ No QA errors")
+}
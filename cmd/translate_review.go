@@ -0,0 +1,211 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/joeblew999/mon-house/pkg/translate"
+	"github.com/joeblew999/mon-house/pkg/translate/events"
+)
+
+// reviewFlags are the translate review options
+type reviewFlags struct {
+	action   string   // "" (list), "approve" or "reject"
+	ids      []string // Extraction IDs (or the hash after #)
+	all      bool     // Every extraction matching status
+	status   string   // Only extractions with this status
+	reviewer string
+	note     string // Why a translation was rejected
+}
+
+// handleTranslateReview handles the review subcommand: list, approve or reject translations
+// VISIBLE CALL FLOW - following ADR 004
+func handleTranslateReview(taskFile string, flags reviewFlags) {
+	// Step 1: Get working directory
+	rootDir, err := os.Getwd()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error getting current directory: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Step 2: Load configuration (need events path)
+	config, err := translate.LoadConfig(rootDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading configuration: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Step 3: Load the task file
+	task, err := translate.LoadTask(rootDir, taskFile)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading task: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Step 4: Without an action, list what is waiting for review (QUERY - read only)
+	if flags.action == "" {
+		listReview(task, taskFile, flags.status)
+		return
+	}
+
+	// Step 5: Select the extractions to act on
+	if len(flags.ids) == 0 && !flags.all {
+		fmt.Fprintf(os.Stderr, "Error: translate review %s needs extraction IDs or --all\n", flags.action)
+		os.Exit(1)
+	}
+	items := translate.SelectExtractions(task, flags.ids, flags.status)
+	if len(items) == 0 {
+		fmt.Fprintf(os.Stderr, "Error: no extractions match\n")
+		os.Exit(1)
+	}
+
+	// Step 6: Create event store (review actions are recorded)
+	eventStore, err := events.NewStore(rootDir, config.Paths.Events)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to create event store: %v\n", err)
+		eventStore = nil
+	}
+	defer func() {
+		if eventStore != nil {
+			eventStore.Close()
+		}
+	}()
+
+	// Step 7: Approve or reject each selected extraction (COMMAND - changes the task)
+	now := time.Now()
+	changed, skipped := 0, 0
+	for _, item := range items {
+		ext := item.Extraction
+		previous := translate.ExtractionStatus(*ext)
+		switch flags.action {
+		case "approve":
+			if previous == translate.StatusApproved {
+				skipped++
+				continue
+			}
+			if err := translate.ApproveExtraction(ext, flags.reviewer, now); err != nil {
+				skipped++ // Nothing to approve
+				continue
+			}
+			if eventStore != nil {
+				eventStore.Append(&events.ExtractionApproved{
+					BaseEvent: events.BaseEvent{
+						Type:      "ExtractionApproved",
+						Occurred:  now,
+						SessionID: eventStore.SessionID(),
+					},
+					TaskFile:       taskFile,
+					FilePath:       item.File,
+					ExtractionID:   ext.ID,
+					PreviousStatus: previous,
+					Reviewer:       flags.reviewer,
+					TargetText:     ext.TargetText,
+				})
+			}
+		case "reject":
+			rejected, err := translate.RejectExtraction(ext, flags.reviewer, now)
+			if err != nil {
+				skipped++ // Nothing to reject
+				continue
+			}
+			if eventStore != nil {
+				eventStore.Append(&events.ExtractionRejected{
+					BaseEvent: events.BaseEvent{
+						Type:      "ExtractionRejected",
+						Occurred:  now,
+						SessionID: eventStore.SessionID(),
+					},
+					TaskFile:       taskFile,
+					FilePath:       item.File,
+					ExtractionID:   ext.ID,
+					PreviousStatus: previous,
+					Reviewer:       flags.reviewer,
+					RejectedText:   rejected,
+					Note:           flags.note,
+				})
+			}
+		}
+		changed++
+	}
+
+	// Step 8: Save the task file
+	if changed > 0 {
+		if err := translate.SaveTask(rootDir, taskFile, task); err != nil {
+			fmt.Fprintf(os.Stderr, "Error saving task file: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	// Step 9: Display results
+	if flags.action == "approve" {
+		fmt.Printf("✓ Approved %d translations as %s", changed, flags.reviewer)
+		if skipped > 0 {
+			fmt.Printf(" (%d skipped: empty or already approved)", skipped)
+		}
+	} else {
+		fmt.Printf("✓ Rejected %d translations as %s", changed, flags.reviewer)
+		if skipped > 0 {
+			fmt.Printf(" (%d skipped: empty)", skipped)
+		}
+	}
+	fmt.Println()
+	printStatusCounts(task)
+	if flags.action == "reject" && changed > 0 {
+		fmt.Printf("\nRejected translations are empty again; re-run: mon-tool translate auto %s\n", taskFile)
+	}
+}
+
+// listReview prints the translations waiting for review (or those with status)
+func listReview(task *translate.Task, taskFile string, status string) {
+	fmt.Printf("\n📝 Review: %s\n", taskFile)
+	fmt.Println("─────────────────────────────────────────────")
+
+	shown := 0
+	for _, item := range translate.SelectExtractions(task, nil, status) {
+		ext := item.Extraction
+		current := translate.ExtractionStatus(*ext)
+		if status == "" && (current == translate.StatusApproved || ext.TargetText == "") {
+			continue // Default list: filled translations not approved yet
+		}
+		shown++
+		id := ext.ID
+		if i := strings.LastIndex(id, "#"); i >= 0 {
+			id = id[i+1:]
+		}
+		fmt.Printf("  [%s] %s %s:%d\n", current, id, item.File, ext.Line)
+		fmt.Printf("      %q\n", ext.SourceText)
+		if ext.TargetText != "" {
+			fmt.Printf("    → %q\n", ext.TargetText)
+		} else if ext.Suggestion != "" {
+			fmt.Printf("    ~ %q (%d%% memory match)\n", ext.Suggestion, ext.MatchScore)
+		}
+		if ext.BackTranslation != "" {
+			fmt.Printf("    ↩ %q (back-translation, %d%% drift)\n", ext.BackTranslation, ext.Drift)
+		}
+		if ext.Reviewer != "" && ext.ReviewedAt != nil {
+			fmt.Printf("      by %s on %s\n", ext.Reviewer, ext.ReviewedAt.Format("2006-01-02 15:04"))
+		}
+	}
+	if shown == 0 {
+		fmt.Println("  Nothing to review")
+	}
+
+	printStatusCounts(task)
+	fmt.Println()
+	fmt.Printf("Approve: mon-tool translate review %s approve <id>... | --all [--status=machine]\n", taskFile)
+	fmt.Printf("Reject:  mon-tool translate review %s reject <id>... [--note=why]\n", taskFile)
+}
+
+// printStatusCounts prints how many extractions of the task have each review status
+func printStatusCounts(task *translate.Task) {
+	counts := translate.CountStatuses(task)
+	var parts []string
+	for _, status := range translate.ReviewStatuses {
+		if counts[status] > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", counts[status], status))
+		}
+	}
+	fmt.Printf("\nStatus: %s\n", strings.Join(parts, ", "))
+}
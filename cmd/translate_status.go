@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/joeblew999/mon-house/pkg/translate"
+)
+
+// handleTranslateStatus handles the status subcommand: coverage and staleness of each target
+// VISIBLE CALL FLOW - following ADR 004
+func handleTranslateStatus(language string, jsonOutput bool, all bool) {
+	// Step 1: Get working directory
+	rootDir, err := os.Getwd()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error getting current directory: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Step 2: Load configuration (folders, targets and events path)
+	config, err := translate.LoadConfig(rootDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading configuration: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Step 3: Select target languages (all unless --language is given)
+	targets := config.Targets
+	if language != "" {
+		target, ok := config.Target(language)
+		if !ok {
+			fmt.Fprintf(os.Stderr, "Error: target language %s not found in config\n", language)
+			os.Exit(1)
+		}
+		targets = []translate.TargetConfig{target}
+	}
+
+	// Step 4: Compare source hashes with the event log (QUERY - read only)
+	statuses, err := translate.TranslationStatus(rootDir, config, targets)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Step 5: Display as JSON or as tables
+	if jsonOutput {
+		data, err := json.MarshalIndent(statuses, "", "  ")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println(string(data))
+		return
+	}
+	for _, status := range statuses {
+		printLanguageStatus(status, all)
+	}
+}
+
+// printLanguageStatus prints the file table of one target language
+// Plain copies that are current are left out unless all is set
+func printLanguageStatus(status translate.LanguageStatus, all bool) {
+	fmt.Printf("\n📋 Status: %s (%s) — %.0f%% translated (%d of %d units)\n",
+		status.LanguageName, status.Language, status.Coverage, status.Translated, status.Extractions)
+	fmt.Println("─────────────────────────────────────────────")
+
+	var parts []string
+	for _, state := range translate.FileStates {
+		if status.States[state] > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", status.States[state], state))
+		}
+	}
+	fmt.Printf("Files: %s\n\n", strings.Join(parts, ", "))
+
+	fmt.Printf("  %-12s %-12s %-12s %8s  %s\n", "STATE", "SOURCE", "TRANSLATED", "COVERAGE", "TARGET")
+	hidden := 0
+	for _, file := range status.Files {
+		if !all && file.State == translate.FileCurrent && file.Extractions == 0 {
+			hidden++
+			continue
+		}
+		sourceHash, translatedHash, fileCoverage := dash(file.SourceHash), dash(file.TranslatedHash), "-"
+		if file.Extractions > 0 {
+			fileCoverage = fmt.Sprintf("%.0f%%", file.Coverage)
+		}
+		edited := ""
+		if file.Edited {
+			edited = " (edited by hand)"
+		}
+		fmt.Printf("  %-12s %-12s %-12s %8s  %s%s\n", file.State, sourceHash, translatedHash, fileCoverage, file.Target, edited)
+	}
+	if hidden > 0 {
+		fmt.Printf("  ... and %d up-to-date copies (--all shows them)\n", hidden)
+	}
+
+	// Hint at the next step
+	fmt.Println()
+	switch {
+	case status.TaskFile != "":
+		fmt.Printf("Task waiting to be applied: %s\n", status.TaskFile)
+	case status.States[translate.FileMissing]+status.States[translate.FileStale]+status.States[translate.FileOrphaned] > 0:
+		fmt.Printf("Out of date; update with: mon-tool translate full --language=%s\n", status.Language)
+	}
+}
+
+// dash returns s, or "-" when it is empty
+func dash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,195 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/joeblew999/mon-house/pkg/translate"
+	"github.com/joeblew999/mon-house/pkg/translate/events"
+)
+
+// verifyFlags are the translate verify options (plus the translate auto provider overrides)
+type verifyFlags struct {
+	threshold float64 // Drift at which a translation is flagged (0 = default)
+	all       bool    // Also verify reviewed and approved translations
+	top       int     // Results shown in the report
+	auto      autoFlags
+}
+
+// handleTranslateVerify handles the verify subcommand: back-translate AI output and flag drift
+// VISIBLE CALL FLOW - following ADR 005 Headless AI Translation
+func handleTranslateVerify(taskFile string, flags verifyFlags) {
+	// Step 1: Get working directory
+	rootDir, err := os.Getwd()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error getting current directory: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Step 2: Load configuration
+	config, err := translate.LoadConfig(rootDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading configuration: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Step 3: Load the task file
+	task, err := translate.LoadTask(rootDir, taskFile)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading task: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Step 4: Create the translator that back-translates (provider from translate.json)
+	aiConfig := flags.auto.apply(config.AI)
+	translator, err := translate.NewTranslator(rootDir, aiConfig)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Step 5: Work out the budget (back-translations count against the AI budgets)
+	spending, err := translate.LoadSpending(rootDir, config.Paths.Events)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to read spending from events: %v\n", err)
+	}
+	budget, _, err := translate.BudgetLimit(aiConfig.Budget, spending, time.Now())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Step 6: Create event store
+	eventStore, err := events.NewStore(rootDir, config.Paths.Events)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to create event store: %v\n", err)
+		eventStore = nil
+	}
+	defer func() {
+		if eventStore != nil {
+			eventStore.Close()
+		}
+	}()
+
+	// Step 7: Print header
+	fmt.Printf("\n━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
+	fmt.Printf("🔁 Back-translation check: %s → %s\n", task.TargetLanguage, task.SourceLanguage)
+	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n\n")
+	fmt.Printf("Using model: %s (%s)\n", translator.Name(), aiConfig.Provider)
+	fmt.Printf("Task file: %s\n\n", taskFile)
+
+	// Step 8: Back-translate and score (Ctrl-C stops the requests in flight)
+	options := translate.VerifyOptions{
+		Threshold:          flags.threshold,
+		All:                flags.all,
+		BatchTokens:        aiConfig.BatchTokens,
+		SourceLanguageName: config.Source.LanguageName,
+		Pricing:            aiConfig.Pricing,
+		BudgetUSD:          budget,
+	}
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	report, verifyErr := translate.VerifyTask(ctx, task, translator, options)
+	stop()
+
+	// Step 9: Save the statuses and back-translations of what was checked
+	if report.Checked > 0 {
+		if err := translate.SaveTask(rootDir, taskFile, task); err != nil {
+			fmt.Fprintf(os.Stderr, "Error saving task file: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	// Emit TranslationVerified event (also when stopped early: the tokens were spent)
+	averageDrift := 0.0
+	for _, result := range report.Results {
+		averageDrift += result.Drift
+	}
+	if report.Checked > 0 {
+		averageDrift /= float64(report.Checked)
+	}
+	if eventStore != nil && (report.Checked > 0 || verifyErr != nil) {
+		verified := &events.TranslationVerified{
+			BaseEvent: events.BaseEvent{
+				Type:      "TranslationVerified",
+				Occurred:  time.Now(),
+				SessionID: eventStore.SessionID(),
+			},
+			TaskFile:       taskFile,
+			TargetLanguage: task.TargetLanguage,
+			Model:          translator.Name(),
+			ItemsChecked:   report.Checked,
+			ItemsFlagged:   report.Flagged,
+			AverageDrift:   averageDrift,
+			InputTokens:    report.Usage.InputTokens,
+			OutputTokens:   report.Usage.OutputTokens,
+			CostUSD:        report.Usage.EstimatedCost,
+		}
+		if verifyErr != nil {
+			verified.Error = verifyErr.Error()
+		}
+		eventStore.Append(verified)
+	}
+
+	if verifyErr != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", verifyErr)
+		if report.Checked > 0 {
+			fmt.Fprintf(os.Stderr, "The %d items checked were saved to %s\n", report.Checked, taskFile)
+		}
+		os.Exit(1)
+	}
+	if report.Checked == 0 {
+		fmt.Println("Nothing to verify (no machine translations; --all checks reviewed and approved ones too)")
+		return
+	}
+
+	// Step 10: Display the worst items
+	threshold := flags.threshold
+	if threshold <= 0 {
+		threshold = translate.DefaultDriftThreshold
+	}
+	fmt.Printf("📊 Checked %d translations: %d flagged (drift ≥ %.0f%% or numbers changed), average drift %.0f%%\n",
+		report.Checked, report.Flagged, threshold*100, averageDrift*100)
+	fmt.Printf("💰 Cost: $%.4f (%d input, %d output tokens)\n\n", report.Usage.EstimatedCost, report.Usage.InputTokens, report.Usage.OutputTokens)
+
+	for i, result := range report.Results {
+		if i == flags.top {
+			fmt.Printf("  ... and %d more\n\n", len(report.Results)-flags.top)
+			break
+		}
+		mark := "✓"
+		if result.Reason != "" {
+			mark = "⚠"
+		}
+		fmt.Printf("  %s %3.0f%% %s %s\n", mark, result.Drift*100, result.File, result.ID)
+		fmt.Printf("      source: %q\n", result.SourceText)
+		fmt.Printf("      target: %q\n", result.TargetText)
+		fmt.Printf("      back:   %q\n", result.BackText)
+		if result.Reason != "" {
+			fmt.Printf("      flagged: %s\n", result.Reason)
+		}
+		fmt.Println()
+	}
+
+	if len(report.Failed) > 0 {
+		fmt.Printf("⚠️  %d translations got no back-translation (not scored)\n\n", len(report.Failed))
+	}
+	if report.Flagged > 0 {
+		fmt.Printf("Flagged translations have status \"flagged\"; review them with:\n")
+		fmt.Printf("  mon-tool translate review %s --status=flagged\n", taskFile)
+	}
+}
+
+// parseThreshold parses a drift threshold as a fraction (0.4 = 40% drift)
+func parseThreshold(value string) (float64, error) {
+	threshold, err := strconv.ParseFloat(value, 64)
+	if err != nil || threshold <= 0 || threshold > 1 {
+		return 0, fmt.Errorf("invalid --threshold %q (a drift between 0 and 1, e.g. 0.4 for 40%%)", value)
+	}
+	return threshold, nil
+}
@@ -2,9 +2,10 @@ module github.com/joeblew999/mon-house
 
 go 1.21
 
-require github.com/itchyny/gojq v0.12.14
-
 require (
-	github.com/google/uuid v1.6.0 // indirect
-	github.com/itchyny/timefmt-go v0.1.5 // indirect
+	github.com/google/uuid v1.6.0
+	github.com/itchyny/gojq v0.12.14
+	github.com/yuin/goldmark v1.7.4
 )
+
+require github.com/itchyny/timefmt-go v0.1.5 // indirect
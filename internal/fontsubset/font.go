@@ -0,0 +1,282 @@
+package fontsubset
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// sfnt versions
+const (
+	flavorTrueType = 0x00010000
+	flavorTrue     = 0x74727565 // 'true' (old Apple TrueType)
+	flavorCFF      = 0x4F54544F // 'OTTO'
+	woffSignature  = 0x774F4646 // 'wOFF'
+	woff2Signature = 0x774F4632 // 'wOF2'
+	ttcSignature   = 0x74746366 // 'ttcf'
+)
+
+// ErrNotSubsettable is returned for fonts that cannot be subset
+// (WOFF2 needs a Brotli decoder, CFF outlines are not subset)
+var ErrNotSubsettable = errors.New("font format cannot be subset")
+
+// Font is an sfnt font (TrueType or OpenType) as a set of tables
+type Font struct {
+	Flavor uint32
+	Tables map[string][]byte
+}
+
+// Format detects a font file format: "ttf", "otf", "woff", "woff2", "ttc" or "" if unknown
+func Format(data []byte) string {
+	if len(data) < 4 {
+		return ""
+	}
+	switch binary.BigEndian.Uint32(data) {
+	case flavorTrueType, flavorTrue:
+		return "ttf"
+	case flavorCFF:
+		return "otf"
+	case woffSignature:
+		return "woff"
+	case woff2Signature:
+		return "woff2"
+	case ttcSignature:
+		return "ttc"
+	}
+	return ""
+}
+
+// Parse reads a TTF, OTF or WOFF file
+func Parse(data []byte) (*Font, error) {
+	switch Format(data) {
+	case "ttf", "otf":
+		return parseSFNT(data)
+	case "woff":
+		return parseWOFF(data)
+	case "woff2":
+		return nil, fmt.Errorf("WOFF2: %w", ErrNotSubsettable)
+	case "ttc":
+		return nil, errors.New("font collections (.ttc) are not supported, extract one font first")
+	}
+	return nil, errors.New("not a TrueType, OpenType or WOFF font")
+}
+
+func parseSFNT(data []byte) (*Font, error) {
+	if len(data) < 12 {
+		return nil, errors.New("truncated font header")
+	}
+	font := &Font{Flavor: binary.BigEndian.Uint32(data), Tables: make(map[string][]byte)}
+	numTables := int(binary.BigEndian.Uint16(data[4:]))
+	if len(data) < 12+16*numTables {
+		return nil, errors.New("truncated table directory")
+	}
+
+	for i := 0; i < numTables; i++ {
+		record := data[12+16*i:]
+		tag := string(record[:4])
+		offset := int(binary.BigEndian.Uint32(record[8:]))
+		length := int(binary.BigEndian.Uint32(record[12:]))
+		if offset < 0 || length < 0 || offset+length > len(data) {
+			return nil, fmt.Errorf("table %s out of bounds", tag)
+		}
+		font.Tables[tag] = data[offset : offset+length]
+	}
+	return font, nil
+}
+
+func parseWOFF(data []byte) (*Font, error) {
+	if len(data) < 44 {
+		return nil, errors.New("truncated WOFF header")
+	}
+	font := &Font{Flavor: binary.BigEndian.Uint32(data[4:]), Tables: make(map[string][]byte)}
+	numTables := int(binary.BigEndian.Uint16(data[12:]))
+	if len(data) < 44+20*numTables {
+		return nil, errors.New("truncated WOFF table directory")
+	}
+
+	for i := 0; i < numTables; i++ {
+		entry := data[44+20*i:]
+		tag := string(entry[:4])
+		offset := int(binary.BigEndian.Uint32(entry[4:]))
+		compLength := int(binary.BigEndian.Uint32(entry[8:]))
+		origLength := int(binary.BigEndian.Uint32(entry[12:]))
+		if offset < 0 || compLength < 0 || offset+compLength > len(data) {
+			return nil, fmt.Errorf("WOFF table %s out of bounds", tag)
+		}
+		table := data[offset : offset+compLength]
+		if compLength < origLength {
+			r, err := zlib.NewReader(bytes.NewReader(table))
+			if err != nil {
+				return nil, fmt.Errorf("WOFF table %s: %w", tag, err)
+			}
+			table, err = io.ReadAll(r)
+			if err != nil {
+				return nil, fmt.Errorf("WOFF table %s: %w", tag, err)
+			}
+		}
+		font.Tables[tag] = table
+	}
+	return font, nil
+}
+
+// tags returns the table tags in sorted order (required order for table directories)
+func (f *Font) tags() []string {
+	tags := make([]string, 0, len(f.Tables))
+	for tag := range f.Tables {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// SFNT serializes the font as a TTF/OTF file with table checksums and head.checkSumAdjustment
+func (f *Font) SFNT() []byte {
+	tags := f.tags()
+	n := len(tags)
+	entrySelector := 0
+	for 1<<(entrySelector+1) <= n {
+		entrySelector++
+	}
+	searchRange := 16 << entrySelector
+
+	if head, ok := f.Tables["head"]; ok && len(head) >= 12 {
+		head = append([]byte(nil), head...)
+		binary.BigEndian.PutUint32(head[8:], 0)
+		f.Tables["head"] = head
+	}
+
+	var out bytes.Buffer
+	header := make([]byte, 12+16*n)
+	binary.BigEndian.PutUint32(header, f.Flavor)
+	binary.BigEndian.PutUint16(header[4:], uint16(n))
+	binary.BigEndian.PutUint16(header[6:], uint16(searchRange))
+	binary.BigEndian.PutUint16(header[8:], uint16(entrySelector))
+	binary.BigEndian.PutUint16(header[10:], uint16(n*16-searchRange))
+
+	offset := len(header)
+	headOffset := -1
+	for i, tag := range tags {
+		table := f.Tables[tag]
+		record := header[12+16*i:]
+		copy(record, tag)
+		binary.BigEndian.PutUint32(record[4:], checksum(table))
+		binary.BigEndian.PutUint32(record[8:], uint32(offset))
+		binary.BigEndian.PutUint32(record[12:], uint32(len(table)))
+		if tag == "head" {
+			headOffset = offset
+		}
+		offset += pad4(len(table))
+	}
+	out.Write(header)
+	for _, tag := range tags {
+		table := f.Tables[tag]
+		out.Write(table)
+		out.Write(make([]byte, pad4(len(table))-len(table)))
+	}
+
+	result := out.Bytes()
+	if headOffset >= 0 && len(f.Tables["head"]) >= 12 {
+		adjustment := 0xB1B0AFBA - checksum(result)
+		binary.BigEndian.PutUint32(result[headOffset+8:], adjustment)
+		binary.BigEndian.PutUint32(f.Tables["head"][8:], adjustment)
+	}
+	return result
+}
+
+// WOFF serializes the font as WOFF 1.0 (zlib-compressed tables)
+func (f *Font) WOFF() ([]byte, error) {
+	f.SFNT() // Sets head.checkSumAdjustment
+	tags := f.tags()
+	n := len(tags)
+
+	totalSfntSize := 12 + 16*n
+	type entry struct {
+		data       []byte
+		origLength int
+		checksum   uint32
+	}
+	entries := make([]entry, n)
+	for i, tag := range tags {
+		table := f.Tables[tag]
+		totalSfntSize += pad4(len(table))
+
+		var compressed bytes.Buffer
+		w := zlib.NewWriter(&compressed)
+		if _, err := w.Write(table); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		data := table
+		if compressed.Len() < len(table) {
+			data = compressed.Bytes()
+		}
+		entries[i] = entry{data: data, origLength: len(table), checksum: checksum(table)}
+	}
+
+	header := make([]byte, 44+20*n)
+	binary.BigEndian.PutUint32(header, woffSignature)
+	binary.BigEndian.PutUint32(header[4:], f.Flavor)
+	binary.BigEndian.PutUint16(header[12:], uint16(n))
+	binary.BigEndian.PutUint32(header[16:], uint32(totalSfntSize))
+	binary.BigEndian.PutUint16(header[20:], 1) // Font version 1.0
+
+	offset := len(header)
+	for i, tag := range tags {
+		record := header[44+20*i:]
+		copy(record, tag)
+		binary.BigEndian.PutUint32(record[4:], uint32(offset))
+		binary.BigEndian.PutUint32(record[8:], uint32(len(entries[i].data)))
+		binary.BigEndian.PutUint32(record[12:], uint32(entries[i].origLength))
+		binary.BigEndian.PutUint32(record[16:], entries[i].checksum)
+		offset += pad4(len(entries[i].data))
+	}
+	binary.BigEndian.PutUint32(header[8:], uint32(offset)) // Total WOFF length
+
+	var out bytes.Buffer
+	out.Write(header)
+	for _, e := range entries {
+		out.Write(e.data)
+		out.Write(make([]byte, pad4(len(e.data))-len(e.data)))
+	}
+	return out.Bytes(), nil
+}
+
+// Weight returns OS/2 usWeightClass (400 if unknown)
+func (f *Font) Weight() int {
+	if os2 := f.Tables["OS/2"]; len(os2) >= 6 {
+		if weight := int(binary.BigEndian.Uint16(os2[4:])); weight > 0 {
+			return weight
+		}
+	}
+	return 400
+}
+
+// Italic reports whether OS/2 fsSelection marks the font italic or oblique
+func (f *Font) Italic() bool {
+	if os2 := f.Tables["OS/2"]; len(os2) >= 64 {
+		selection := binary.BigEndian.Uint16(os2[62:])
+		return selection&0x0001 != 0 || selection&0x0200 != 0
+	}
+	return false
+}
+
+// checksum is the sfnt table checksum (sum of big-endian uint32 words, zero padded)
+func checksum(data []byte) uint32 {
+	var sum uint32
+	for i := 0; i < len(data); i += 4 {
+		var word [4]byte
+		copy(word[:], data[i:])
+		sum += binary.BigEndian.Uint32(word[:])
+	}
+	return sum
+}
+
+func pad4(n int) int {
+	return (n + 3) &^ 3
+}
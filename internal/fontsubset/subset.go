@@ -0,0 +1,241 @@
+package fontsubset
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// Subset removes the outlines of glyphs that are only reachable from characters
+// not in keep, and returns the kept characters the font covers (for unicode-range)
+//
+// Glyph IDs do not change, so cmap, hmtx and the OpenType layout tables stay valid.
+// Glyphs that no character maps to (ligatures, Thai mark variants selected through
+// GSUB) are always kept, as are the components of kept composite glyphs
+func (f *Font) Subset(keep map[rune]bool) ([]rune, error) {
+	if f.Flavor == flavorCFF {
+		return nil, fmt.Errorf("CFF outlines: %w", ErrNotSubsettable)
+	}
+	head, maxp, loca, glyf := f.Tables["head"], f.Tables["maxp"], f.Tables["loca"], f.Tables["glyf"]
+	if len(head) < 54 || len(maxp) < 6 || loca == nil || glyf == nil || f.Tables["cmap"] == nil {
+		return nil, errors.New("font has no TrueType outlines (head, maxp, loca, glyf, cmap)")
+	}
+
+	numGlyphs := int(binary.BigEndian.Uint16(maxp[4:]))
+	longLoca := binary.BigEndian.Uint16(head[50:]) == 1
+	offsets, err := parseLoca(loca, numGlyphs, longLoca, len(glyf))
+	if err != nil {
+		return nil, err
+	}
+
+	mapping, err := parseCmap(f.Tables["cmap"])
+	if err != nil {
+		return nil, err
+	}
+
+	// Glyphs reachable from characters: drop them unless a kept character uses them
+	mapped := make(map[uint16]bool)
+	used := map[uint16]bool{0: true} // .notdef
+	var covered []rune
+	for r, gid := range mapping {
+		if gid == 0 || int(gid) >= numGlyphs {
+			continue
+		}
+		mapped[gid] = true
+		if keep[r] {
+			used[gid] = true
+			covered = append(covered, r)
+		}
+	}
+	sort.Slice(covered, func(i, j int) bool { return covered[i] < covered[j] })
+
+	retained := make([]bool, numGlyphs)
+	var queue []uint16
+	for gid := 0; gid < numGlyphs; gid++ {
+		if used[uint16(gid)] || !mapped[uint16(gid)] {
+			retained[gid] = true
+			queue = append(queue, uint16(gid))
+		}
+	}
+
+	// Components of composite glyphs
+	for len(queue) > 0 {
+		gid := queue[0]
+		queue = queue[1:]
+		for _, component := range compositeComponents(glyf[offsets[gid]:offsets[gid+1]]) {
+			if int(component) < numGlyphs && !retained[component] {
+				retained[component] = true
+				queue = append(queue, component)
+			}
+		}
+	}
+
+	// Rebuild glyf and a long-format loca
+	var newGlyf []byte
+	newLoca := make([]byte, 4*(numGlyphs+1))
+	for gid := 0; gid < numGlyphs; gid++ {
+		binary.BigEndian.PutUint32(newLoca[4*gid:], uint32(len(newGlyf)))
+		if retained[gid] {
+			newGlyf = append(newGlyf, glyf[offsets[gid]:offsets[gid+1]]...)
+			for len(newGlyf)%4 != 0 {
+				newGlyf = append(newGlyf, 0)
+			}
+		}
+	}
+	binary.BigEndian.PutUint32(newLoca[4*numGlyphs:], uint32(len(newGlyf)))
+
+	newHead := append([]byte(nil), head...)
+	binary.BigEndian.PutUint16(newHead[50:], 1)
+	f.Tables["head"] = newHead
+	f.Tables["loca"] = newLoca
+	f.Tables["glyf"] = newGlyf
+	delete(f.Tables, "DSIG") // The signature no longer matches
+
+	return covered, nil
+}
+
+func parseLoca(loca []byte, numGlyphs int, long bool, glyfLength int) ([]int, error) {
+	size := 2
+	if long {
+		size = 4
+	}
+	if len(loca) < size*(numGlyphs+1) {
+		return nil, errors.New("truncated loca table")
+	}
+
+	offsets := make([]int, numGlyphs+1)
+	for i := range offsets {
+		if long {
+			offsets[i] = int(binary.BigEndian.Uint32(loca[4*i:]))
+		} else {
+			offsets[i] = 2 * int(binary.BigEndian.Uint16(loca[2*i:]))
+		}
+		if offsets[i] > glyfLength || (i > 0 && offsets[i] < offsets[i-1]) {
+			return nil, fmt.Errorf("invalid loca offset for glyph %d", i)
+		}
+	}
+	return offsets, nil
+}
+
+// compositeComponents returns the glyph IDs a composite glyph is built from
+func compositeComponents(glyph []byte) []uint16 {
+	if len(glyph) < 10 || int16(binary.BigEndian.Uint16(glyph)) >= 0 {
+		return nil
+	}
+
+	const (
+		argsAreWords   = 0x0001
+		haveScale      = 0x0008
+		moreComponents = 0x0020
+		haveXYScale    = 0x0040
+		haveTwoByTwo   = 0x0080
+	)
+	var components []uint16
+	for pos := 10; pos+4 <= len(glyph); {
+		flags := binary.BigEndian.Uint16(glyph[pos:])
+		components = append(components, binary.BigEndian.Uint16(glyph[pos+2:]))
+		pos += 4
+		if flags&argsAreWords != 0 {
+			pos += 4
+		} else {
+			pos += 2
+		}
+		switch {
+		case flags&haveScale != 0:
+			pos += 2
+		case flags&haveXYScale != 0:
+			pos += 4
+		case flags&haveTwoByTwo != 0:
+			pos += 8
+		}
+		if flags&moreComponents == 0 {
+			break
+		}
+	}
+	return components
+}
+
+// parseCmap merges every Unicode subtable in format 4 or 12 into one character map
+func parseCmap(cmap []byte) (map[rune]uint16, error) {
+	if len(cmap) < 4 {
+		return nil, errors.New("truncated cmap table")
+	}
+	mapping := make(map[rune]uint16)
+	numTables := int(binary.BigEndian.Uint16(cmap[2:]))
+	for i := 0; i < numTables && 4+8*i+8 <= len(cmap); i++ {
+		record := cmap[4+8*i:]
+		platform := binary.BigEndian.Uint16(record)
+		encoding := binary.BigEndian.Uint16(record[2:])
+		offset := int(binary.BigEndian.Uint32(record[4:]))
+		unicode := platform == 0 || (platform == 3 && (encoding == 0 || encoding == 1 || encoding == 10))
+		if !unicode || offset+4 > len(cmap) {
+			continue
+		}
+
+		subtable := cmap[offset:]
+		switch binary.BigEndian.Uint16(subtable) {
+		case 4:
+			parseCmapFormat4(subtable, mapping)
+		case 12:
+			parseCmapFormat12(subtable, mapping)
+		}
+	}
+	return mapping, nil
+}
+
+func parseCmapFormat4(table []byte, mapping map[rune]uint16) {
+	if len(table) < 14 {
+		return
+	}
+	segCountX2 := int(binary.BigEndian.Uint16(table[6:]))
+	ends := 14
+	starts := ends + segCountX2 + 2
+	deltas := starts + segCountX2
+	rangeOffsets := deltas + segCountX2
+	if rangeOffsets+segCountX2 > len(table) {
+		return
+	}
+
+	for seg := 0; seg < segCountX2/2; seg++ {
+		end := int(binary.BigEndian.Uint16(table[ends+2*seg:]))
+		start := int(binary.BigEndian.Uint16(table[starts+2*seg:]))
+		delta := binary.BigEndian.Uint16(table[deltas+2*seg:])
+		rangeOffset := int(binary.BigEndian.Uint16(table[rangeOffsets+2*seg:]))
+		for c := start; c <= end && c != 0xFFFF; c++ {
+			var gid uint16
+			if rangeOffset == 0 {
+				gid = uint16(c) + delta
+			} else {
+				pos := rangeOffsets + 2*seg + rangeOffset + 2*(c-start)
+				if pos+2 > len(table) {
+					continue
+				}
+				if gid = binary.BigEndian.Uint16(table[pos:]); gid != 0 {
+					gid += delta
+				}
+			}
+			if gid != 0 {
+				mapping[rune(c)] = gid
+			}
+		}
+	}
+}
+
+func parseCmapFormat12(table []byte, mapping map[rune]uint16) {
+	if len(table) < 16 {
+		return
+	}
+	numGroups := int(binary.BigEndian.Uint32(table[12:]))
+	for i := 0; i < numGroups && 16+12*i+12 <= len(table); i++ {
+		group := table[16+12*i:]
+		start := binary.BigEndian.Uint32(group)
+		end := binary.BigEndian.Uint32(group[4:])
+		startGlyph := binary.BigEndian.Uint32(group[8:])
+		for c := start; c <= end && c <= 0x10FFFF; c++ {
+			if gid := startGlyph + (c - start); gid != 0 && gid <= 0xFFFF {
+				mapping[rune(c)] = uint16(gid)
+			}
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package injector
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/joeblew999/mon-house/internal/fontsubset"
+)
+
+// EmbeddedFont is a font file listed in typography.fontFamilies.<family>.embed
+type EmbeddedFont struct {
+	Family string // CSS font-family the @font-face declares (the family's "name")
+	Path   string
+	Weight string // Optional override; read from the font when empty
+	Style  string // Optional override; read from the font when empty
+}
+
+// LoadEmbeddedFonts reads the fonts to embed from drawing-standards.json
+// Each family may list "embed": ["fonts/x.ttf", {"file": "fonts/x-bold.woff", "weight": "700"}];
+// paths are relative to the standards file
+// Only fonts that can be subset (TrueType-outline TTF, OTF or WOFF) are accepted:
+// WOFF2 and CFF fonts are rejected rather than embedded whole in every drawing
+func LoadEmbeddedFonts(standards interface{}, baseDir string) ([]EmbeddedFont, error) {
+	root, _ := standards.(map[string]interface{})
+	ds, _ := root["drawingStandards"].(map[string]interface{})
+	typography, _ := ds["typography"].(map[string]interface{})
+	families, _ := typography["fontFamilies"].(map[string]interface{})
+
+	keys := make([]string, 0, len(families))
+	for key := range families {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var fonts []EmbeddedFont
+	for _, key := range keys {
+		family, _ := families[key].(map[string]interface{})
+		name, _ := family["name"].(string)
+		entries, _ := family["embed"].([]interface{})
+		for _, entry := range entries {
+			font := EmbeddedFont{Family: name}
+			switch e := entry.(type) {
+			case string:
+				font.Path = e
+			case map[string]interface{}:
+				font.Path, _ = e["file"].(string)
+				if weight, ok := e["weight"]; ok {
+					font.Weight = fmt.Sprint(weight)
+				}
+				font.Style, _ = e["style"].(string)
+			}
+			if name == "" || font.Path == "" {
+				return nil, fmt.Errorf("typography.fontFamilies.%s.embed: needs a family name and a file", key)
+			}
+			if !filepath.IsAbs(font.Path) {
+				font.Path = filepath.Join(baseDir, font.Path)
+			}
+			if err := checkSubsettable(font.Path); err != nil {
+				return nil, fmt.Errorf("typography.fontFamilies.%s.embed: %s: %w", key, font.Path, err)
+			}
+			fonts = append(fonts, font)
+		}
+	}
+	return fonts, nil
+}
+
+// FontFaceCSS returns @font-face rules embedding the fonts as base64 data URLs,
+// subset to the characters of the SVG's <text> elements
+// Fonts are subset and embedded as WOFF (LoadEmbeddedFonts only accepts fonts that can be)
+func FontFaceCSS(svgPath string, fonts []EmbeddedFont) (string, error) {
+	if len(fonts) == 0 {
+		return "", nil
+	}
+
+	content, err := os.ReadFile(svgPath)
+	if err != nil {
+		return "", fmt.Errorf("reading SVG: %w", err)
+	}
+	used, err := textRunes(content)
+	if err != nil {
+		return "", fmt.Errorf("parsing SVG: %w", err)
+	}
+
+	var sb strings.Builder
+	sb.WriteString("\n/* Embedded fonts (subset to the characters in this drawing) */\n")
+	for _, font := range fonts {
+		rule, err := fontFace(font, used)
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", font.Path, err)
+		}
+		sb.WriteString(rule)
+	}
+	return sb.String(), nil
+}
+
+// checkSubsettable returns an error for a font that cannot be read or subset
+// (WOFF2 needs a Brotli decoder, CFF outlines are not supported)
+func checkSubsettable(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading font: %w", err)
+	}
+	parsed, err := fontsubset.Parse(data)
+	if err == nil {
+		_, err = parsed.Subset(map[rune]bool{})
+	}
+	if errors.Is(err, fontsubset.ErrNotSubsettable) {
+		return fmt.Errorf("%w; use a TTF or WOFF file (e.g. fonttools ttLib.woff2 decompress)", err)
+	}
+	return err
+}
+
+// fontFace builds the @font-face rule for one font
+func fontFace(font EmbeddedFont, used map[rune]bool) (string, error) {
+	data, err := os.ReadFile(font.Path)
+	if err != nil {
+		return "", fmt.Errorf("reading font: %w", err)
+	}
+
+	parsed, err := fontsubset.Parse(data)
+	if err != nil {
+		return "", err
+	}
+	weight, style := fmt.Sprint(parsed.Weight()), "normal"
+	if parsed.Italic() {
+		style = "italic"
+	}
+	covered, err := parsed.Subset(used)
+	if err != nil {
+		return "", err
+	}
+	if data, err = parsed.WOFF(); err != nil {
+		return "", err
+	}
+	note := fmt.Sprintf("subset to %d characters", len(covered))
+	unicodeRange := formatUnicodeRange(covered)
+	if font.Weight != "" {
+		weight = font.Weight
+	}
+	if font.Style != "" {
+		style = font.Style
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "/* %s: %s */\n", filepath.Base(font.Path), note)
+	fmt.Fprintf(&sb, "@font-face { font-family: %q; font-weight: %s; font-style: %s; ", font.Family, weight, style)
+	if unicodeRange != "" {
+		fmt.Fprintf(&sb, "unicode-range: %s; ", unicodeRange)
+	}
+	fmt.Fprintf(&sb, "src: url(data:font/woff;base64,%s) format(\"woff\"); }\n", base64.StdEncoding.EncodeToString(data))
+	return sb.String(), nil
+}
+
+// textRunes collects the characters of every <text> element (including <tspan>s)
+func textRunes(content []byte) (map[rune]bool, error) {
+	used := map[rune]bool{' ': true}
+	decoder := xml.NewDecoder(bytes.NewReader(content))
+	depth := 0 // Nesting inside <text>
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return used, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			if depth > 0 || t.Name.Local == "text" {
+				depth++
+			}
+		case xml.EndElement:
+			if depth > 0 {
+				depth--
+			}
+		case xml.CharData:
+			if depth > 0 {
+				for _, r := range strings.TrimSpace(string(t)) {
+					used[r] = true
+				}
+			}
+		}
+	}
+}
+
+// formatUnicodeRange writes sorted characters as a CSS unicode-range (U+20-7E, U+E01)
+func formatUnicodeRange(runes []rune) string {
+	var ranges []string
+	for i := 0; i < len(runes); {
+		j := i
+		for j+1 < len(runes) && runes[j+1] == runes[j]+1 {
+			j++
+		}
+		if i == j {
+			ranges = append(ranges, fmt.Sprintf("U+%X", runes[i]))
+		} else {
+			ranges = append(ranges, fmt.Sprintf("U+%X-%X", runes[i], runes[j]))
+		}
+		i = j + 1
+	}
+	return strings.Join(ranges, ", ")
+}
@@ -0,0 +1,108 @@
+package ai
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// DefaultBatchTokens is the estimated output budget of one request, kept well
+// under DefaultMaxOutputTokens so translations are not cut off
+const DefaultBatchTokens = 2500
+
+// DefaultMaxOutputTokens is the output limit of one response unless ai.max_output_tokens
+// raises it (the smallest limit of the supported chat models)
+const DefaultMaxOutputTokens = 4096
+
+// responseHeadroom is added to the estimated output of a request when setting
+// max_tokens, for translations longer than estimated
+const responseHeadroom = 256
+
+// OutputLimiter is implemented by translators whose responses are capped at a
+// number of output tokens (max_tokens)
+type OutputLimiter interface {
+	MaxOutputTokens() int
+}
+
+// OutputLimit returns the output token limit of a translator's responses (0 = none)
+func OutputLimit(translator Translator) int {
+	if limiter, ok := translator.(OutputLimiter); ok {
+		return limiter.MaxOutputTokens()
+	}
+	return 0
+}
+
+// CheckBatchTokens rejects a batch budget the translator cannot return in one response
+func CheckBatchTokens(translator Translator, budget int) error {
+	if limit := OutputLimit(translator); limit > 0 && budget > limit {
+		return fmt.Errorf("batch_tokens %d is above the %d output tokens %s can return in one response (raise ai.max_output_tokens if the model allows more)",
+			budget, limit, translator.Name())
+	}
+	return nil
+}
+
+// ResponseTokens returns max_tokens for a request: the estimated output of its
+// items plus half again and responseHeadroom, capped at limit
+func ResponseTokens(items []TranslationItem, limit int) int {
+	estimate := 0
+	for _, item := range items {
+		estimate += EstimateOutputTokens(item)
+	}
+	tokens := estimate + estimate/2 + responseHeadroom
+	if limit > 0 && tokens > limit {
+		tokens = limit
+	}
+	return tokens
+}
+
+// itemOverhead is the estimated tokens of one item's JSON wrapper in the response
+// ({"id": "12", "target_text": "..."},)
+const itemOverhead = 15
+
+// EstimateTokens roughly estimates the tokens of a text without a tokenizer
+// ASCII averages about 4 characters per token; other scripts (Thai, CJK,
+// accented letters) are counted as one token per character
+func EstimateTokens(text string) int {
+	ascii, other := 0, 0
+	for _, r := range text {
+		if r < utf8.RuneSelf {
+			ascii++
+		} else {
+			other++
+		}
+	}
+	return (ascii+3)/4 + other
+}
+
+// EstimateOutputTokens estimates the response tokens for translating an item
+// Translations are assumed up to twice the source's tokens (Thai tokenizes
+// far worse than English)
+func EstimateOutputTokens(item TranslationItem) int {
+	return itemOverhead + 2*EstimateTokens(item.SourceText)
+}
+
+// Batches splits items into consecutive batches whose estimated output stays
+// within budget (DefaultBatchTokens when budget <= 0)
+// An item larger than the budget on its own gets a batch to itself (with a
+// max_tokens sized for it; see ResponseTokens)
+func Batches(items []TranslationItem, budget int) [][]TranslationItem {
+	if budget <= 0 {
+		budget = DefaultBatchTokens
+	}
+
+	var batches [][]TranslationItem
+	var current []TranslationItem
+	used := 0
+	for _, item := range items {
+		cost := EstimateOutputTokens(item)
+		if len(current) > 0 && used+cost > budget {
+			batches = append(batches, current)
+			current, used = nil, 0
+		}
+		current = append(current, item)
+		used += cost
+	}
+	if len(current) > 0 {
+		batches = append(batches, current)
+	}
+	return batches
+}
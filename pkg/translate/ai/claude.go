@@ -2,19 +2,21 @@ package ai
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"os"
-	"strings"
 )
 
 // ClaudeTranslator implements the Translator interface using Anthropic's Claude API
 type ClaudeTranslator struct {
-	APIKey string
-	Model  string // "claude-3-5-sonnet-20241022", etc.
-	client *http.Client
+	APIKey    string
+	Model     string // "claude-3-5-sonnet-20241022", etc.
+	Endpoint  string // Messages API URL (default: https://api.anthropic.com/v1/messages)
+	Retry     RetryPolicy
+	MaxTokens int // Output limit of one response (default: DefaultMaxOutputTokens)
+	client    *http.Client
 }
 
 // NewClaudeTranslator creates a new Claude translator
@@ -26,9 +28,12 @@ func NewClaudeTranslator(apiKey string, model string) *ClaudeTranslator {
 		model = "claude-3-5-sonnet-20241022"
 	}
 	return &ClaudeTranslator{
-		APIKey: apiKey,
-		Model:  model,
-		client: &http.Client{},
+		APIKey:    apiKey,
+		Model:     model,
+		Endpoint:  "https://api.anthropic.com/v1/messages",
+		Retry:     DefaultRetryPolicy,
+		MaxTokens: DefaultMaxOutputTokens,
+		client:    &http.Client{Timeout: DefaultTimeout},
 	}
 }
 
@@ -37,25 +42,40 @@ func (c *ClaudeTranslator) Name() string {
 	return c.Model
 }
 
+// MaxOutputTokens returns the output limit of one response
+func (c *ClaudeTranslator) MaxOutputTokens() int {
+	return c.MaxTokens
+}
+
 // Translate performs batch translation using Claude API
-func (c *ClaudeTranslator) Translate(req *TranslationRequest) (*TranslationResponse, error) {
+// Rate limits (429), overload (529) and network errors are retried with backoff
+// max_tokens is sized to the batch; a cut-off response is marked Truncated
+func (c *ClaudeTranslator) Translate(ctx context.Context, req *TranslationRequest) (*TranslationResponse, error) {
 	if c.APIKey == "" {
 		return nil, fmt.Errorf("Claude API key not set (use ANTHROPIC_API_KEY env var)")
 	}
 
 	// Build the prompt
-	prompt := c.buildPrompt(req)
+	prompt := buildPrompt(req)
 
-	// Call Claude API
+	// Call Claude API, forcing the submit_translations tool so the answer is structured JSON
 	apiReq := map[string]interface{}{
 		"model": c.Model,
-		"max_tokens": 4096,
+		"max_tokens": ResponseTokens(req.Items, c.MaxTokens),
 		"messages": []map[string]string{
 			{
 				"role": "user",
 				"content": prompt,
 			},
 		},
+		"tools": []map[string]interface{}{
+			{
+				"name":         "submit_translations",
+				"description":  "Submit the translation of every item",
+				"input_schema": responseSchema,
+			},
+		},
+		"tool_choice": map[string]string{"type": "tool", "name": "submit_translations"},
 	}
 
 	jsonData, err := json.Marshal(apiReq)
@@ -63,35 +83,28 @@ func (c *ClaudeTranslator) Translate(req *TranslationRequest) (*TranslationRespo
 		return nil, fmt.Errorf("failed to marshal API request: %w", err)
 	}
 
-	httpReq, err := http.NewRequest("POST", "https://api.anthropic.com/v1/messages", bytes.NewBuffer(jsonData))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create HTTP request: %w", err)
-	}
-
-	httpReq.Header.Set("Content-Type", "application/json")
-	httpReq.Header.Set("x-api-key", c.APIKey)
-	httpReq.Header.Set("anthropic-version", "2023-06-01")
-
-	resp, err := c.client.Do(httpReq)
-	if err != nil {
-		return nil, fmt.Errorf("failed to call Claude API: %w", err)
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
+	body, err := send(ctx, c.client, c.Retry, "Claude", func(ctx context.Context) (*http.Request, error) {
+		httpReq, err := http.NewRequestWithContext(ctx, "POST", c.Endpoint, bytes.NewReader(jsonData))
+		if err != nil {
+			return nil, err
+		}
+		httpReq.Header.Set("Content-Type", "application/json")
+		httpReq.Header.Set("x-api-key", c.APIKey)
+		httpReq.Header.Set("anthropic-version", "2023-06-01")
+		return httpReq, nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to read API response: %w", err)
-	}
-
-	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("Claude API error (status %d): %s", resp.StatusCode, string(body))
+		return nil, err
 	}
 
 	// Parse Claude response
 	var apiResp struct {
 		Content []struct {
-			Text string `json:"text"`
+			Type  string          `json:"type"` // "tool_use" or "text"
+			Text  string          `json:"text"`
+			Input json.RawMessage `json:"input"`
 		} `json:"content"`
+		StopReason string `json:"stop_reason"` // "max_tokens" when the output was cut off
 		Usage struct {
 			InputTokens  int `json:"input_tokens"`
 			OutputTokens int `json:"output_tokens"`
@@ -106,21 +119,29 @@ func (c *ClaudeTranslator) Translate(req *TranslationRequest) (*TranslationRespo
 		return nil, fmt.Errorf("no content in API response")
 	}
 
-	// Parse translations from Claude's response
-	translations, err := c.parseTranslations(apiResp.Content[0].Text, req.Items)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse translations: %w", err)
+	// Validate the tool input (or a plain JSON text answer) against the requested items
+	var translations []TranslationItem
+	failures := failAll(req.Items, "no translations in response")
+	for _, block := range apiResp.Content {
+		if block.Type == "tool_use" {
+			translations, failures = decodeTranslations(block.Input, req.Items)
+			break
+		}
+		if block.Type == "text" {
+			translations, failures = parseTranslations(block.Text, req.Items)
+		}
 	}
 
-	// Calculate estimated cost (rough estimate)
-	inputCost := float64(apiResp.Usage.InputTokens) * 0.000003   // $3 per 1M input tokens
-	outputCost := float64(apiResp.Usage.OutputTokens) * 0.000015 // $15 per 1M output tokens
-	totalCost := inputCost + outputCost
+	// Calculate estimated cost from the model's list price (0 for unknown models)
+	totalCost, _ := DefaultPricing.Cost(c.Model, apiResp.Usage.InputTokens, apiResp.Usage.OutputTokens)
 
 	return &TranslationResponse{
 		Success:        true,
 		ItemsProcessed: len(translations),
+		ItemsFailed:    len(failures),
+		Failures:       failures,
 		Translations:   translations,
+		Truncated:      apiResp.StopReason == "max_tokens",
 		Usage: Usage{
 			InputTokens:   apiResp.Usage.InputTokens,
 			OutputTokens:  apiResp.Usage.OutputTokens,
@@ -129,83 +150,3 @@ func (c *ClaudeTranslator) Translate(req *TranslationRequest) (*TranslationRespo
 		},
 	}, nil
 }
-
-// buildPrompt constructs the translation prompt for Claude
-func (c *ClaudeTranslator) buildPrompt(req *TranslationRequest) string {
-	var sb strings.Builder
-
-	sb.WriteString(fmt.Sprintf("You are a professional translator specializing in %s.\n\n", req.Domain))
-	sb.WriteString(fmt.Sprintf("Translate the following text from %s (%s) to %s (%s).\n\n",
-		req.SourceLanguage, req.SourceLanguage, req.TargetLanguage, req.LanguageName))
-
-	if len(req.Terminology) > 0 {
-		sb.WriteString("TERMINOLOGY (use these exact translations):\n")
-		for en, target := range req.Terminology {
-			sb.WriteString(fmt.Sprintf("- %s → %s\n", en, target))
-		}
-		sb.WriteString("\n")
-	}
-
-	if len(req.Notes) > 0 {
-		sb.WriteString("TRANSLATION NOTES:\n")
-		for _, note := range req.Notes {
-			sb.WriteString(fmt.Sprintf("- %s\n", note))
-		}
-		sb.WriteString("\n")
-	}
-
-	sb.WriteString("TEXTS TO TRANSLATE:\n")
-	sb.WriteString("Return a JSON array with the translations in this exact format:\n")
-	sb.WriteString("[\n")
-	sb.WriteString("  {\"id\": \"ID_HERE\", \"target_text\": \"TRANSLATION_HERE\"},\n")
-	sb.WriteString("  ...\n")
-	sb.WriteString("]\n\n")
-
-	sb.WriteString("Items:\n")
-	for _, item := range req.Items {
-		sb.WriteString(fmt.Sprintf("ID: %s\n", item.ID))
-		sb.WriteString(fmt.Sprintf("Context: %s\n", item.Context))
-		sb.WriteString(fmt.Sprintf("Source: %s\n", item.SourceText))
-		sb.WriteString("\n")
-	}
-
-	return sb.String()
-}
-
-// parseTranslations extracts translations from Claude's response
-func (c *ClaudeTranslator) parseTranslations(response string, originalItems []TranslationItem) ([]TranslationItem, error) {
-	// Find JSON array in response
-	startIdx := strings.Index(response, "[")
-	endIdx := strings.LastIndex(response, "]")
-
-	if startIdx == -1 || endIdx == -1 {
-		return nil, fmt.Errorf("no JSON array found in response")
-	}
-
-	jsonStr := response[startIdx : endIdx+1]
-
-	var parsed []struct {
-		ID         string `json:"id"`
-		TargetText string `json:"target_text"`
-	}
-
-	if err := json.Unmarshal([]byte(jsonStr), &parsed); err != nil {
-		return nil, fmt.Errorf("failed to parse JSON: %w", err)
-	}
-
-	// Match translations back to original items by ID
-	idMap := make(map[string]string)
-	for _, p := range parsed {
-		idMap[p.ID] = p.TargetText
-	}
-
-	result := make([]TranslationItem, len(originalItems))
-	for i, item := range originalItems {
-		result[i] = item
-		if translation, ok := idMap[item.ID]; ok {
-			result[i].TargetText = translation
-		}
-	}
-
-	return result, nil
-}
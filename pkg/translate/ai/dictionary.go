@@ -0,0 +1,87 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+)
+
+// DictionaryTranslator is a deterministic offline provider for testing the
+// headless pipeline: each text is looked up in the request terminology, then
+// in a dictionary file, and otherwise echoed as "language: source" (no
+// brackets or other Markdown markup, so the echo passes QA)
+type DictionaryTranslator struct {
+	Path string // JSON object of source → target; {language} is replaced by the target language
+
+	mu      sync.Mutex
+	entries map[string]map[string]string // Loaded dictionaries per target language
+}
+
+// NewDictionaryTranslator creates a dictionary translator (path may be empty: echo only)
+func NewDictionaryTranslator(path string) *DictionaryTranslator {
+	return &DictionaryTranslator{Path: path, entries: make(map[string]map[string]string)}
+}
+
+// Name returns the translator name
+func (d *DictionaryTranslator) Name() string {
+	return "dictionary"
+}
+
+// Translate fills every item without calling any service
+func (d *DictionaryTranslator) Translate(ctx context.Context, req *TranslationRequest) (*TranslationResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	dictionary, err := d.load(req.TargetLanguage)
+	if err != nil {
+		return nil, err
+	}
+
+	translations := make([]TranslationItem, len(req.Items))
+	for i, item := range req.Items {
+		translations[i] = item
+		if target, ok := req.Terminology[item.SourceText]; ok {
+			translations[i].TargetText = target
+		} else if target, ok := dictionary[item.SourceText]; ok {
+			translations[i].TargetText = target
+		} else {
+			translations[i].TargetText = fmt.Sprintf("%s: %s", req.TargetLanguage, item.SourceText)
+		}
+	}
+
+	return &TranslationResponse{
+		Success:        true,
+		ItemsProcessed: len(translations),
+		Translations:   translations,
+	}, nil
+}
+
+// load reads the dictionary of a target language once (a missing file is an empty dictionary)
+func (d *DictionaryTranslator) load(language string) (map[string]string, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if dictionary, ok := d.entries[language]; ok {
+		return dictionary, nil
+	}
+
+	dictionary := make(map[string]string)
+	if d.Path != "" {
+		path := strings.ReplaceAll(d.Path, "{language}", language)
+		data, err := os.ReadFile(path)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, fmt.Errorf("failed to read dictionary: %w", err)
+		}
+		if err == nil {
+			if err := json.Unmarshal(data, &dictionary); err != nil {
+				return nil, fmt.Errorf("failed to parse dictionary %s: %w", path, err)
+			}
+		}
+	}
+	d.entries[language] = dictionary
+	return dictionary, nil
+}
@@ -0,0 +1,132 @@
+package ai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// OpenAITranslator implements the Translator interface with the OpenAI chat
+// completions API, which local servers (Ollama, llama.cpp, vLLM) also speak
+type OpenAITranslator struct {
+	APIKey    string // Optional for local servers
+	Model     string // "gpt-4o-mini", "llama3.1", etc.
+	Endpoint  string // Base URL ending in /v1 (default: https://api.openai.com/v1)
+	Retry     RetryPolicy
+	MaxTokens int // Output limit of one response (default: DefaultMaxOutputTokens)
+	client    *http.Client
+}
+
+// NewOpenAITranslator creates a translator for an OpenAI-compatible endpoint
+// The key falls back to OPENAI_API_KEY only for the OpenAI endpoint itself
+func NewOpenAITranslator(apiKey string, model string, endpoint string) *OpenAITranslator {
+	if endpoint == "" {
+		endpoint = "https://api.openai.com/v1"
+		if apiKey == "" {
+			apiKey = os.Getenv("OPENAI_API_KEY")
+		}
+	}
+	if model == "" {
+		model = "gpt-4o-mini"
+	}
+	return &OpenAITranslator{
+		APIKey:    apiKey,
+		Model:     model,
+		Endpoint:  strings.TrimSuffix(endpoint, "/"),
+		Retry:     DefaultRetryPolicy,
+		MaxTokens: DefaultMaxOutputTokens,
+		client:    &http.Client{Timeout: DefaultTimeout},
+	}
+}
+
+// Name returns the translator name
+func (o *OpenAITranslator) Name() string {
+	return o.Model
+}
+
+// MaxOutputTokens returns the output limit of one response
+func (o *OpenAITranslator) MaxOutputTokens() int {
+	return o.MaxTokens
+}
+
+// Translate performs batch translation using the chat completions API
+// Rate limits, overload and network errors are retried with backoff
+// max_tokens is sized to the batch; a cut-off response is marked Truncated
+func (o *OpenAITranslator) Translate(ctx context.Context, req *TranslationRequest) (*TranslationResponse, error) {
+	apiReq := map[string]interface{}{
+		"model":      o.Model,
+		"max_tokens": ResponseTokens(req.Items, o.MaxTokens),
+		// JSON mode (OpenAI, Ollama, llama.cpp): the reply is always a JSON object
+		"response_format": map[string]string{"type": "json_object"},
+		"messages": []map[string]string{
+			{
+				"role":    "user",
+				"content": buildPrompt(req),
+			},
+		},
+	}
+
+	jsonData, err := json.Marshal(apiReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal API request: %w", err)
+	}
+
+	body, err := send(ctx, o.client, o.Retry, "chat completions", func(ctx context.Context) (*http.Request, error) {
+		httpReq, err := http.NewRequestWithContext(ctx, "POST", o.Endpoint+"/chat/completions", bytes.NewReader(jsonData))
+		if err != nil {
+			return nil, err
+		}
+		httpReq.Header.Set("Content-Type", "application/json")
+		if o.APIKey != "" {
+			httpReq.Header.Set("Authorization", "Bearer "+o.APIKey)
+		}
+		return httpReq, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var apiResp struct {
+		Choices []struct {
+			Message struct {
+				Content string `json:"content"`
+			} `json:"message"`
+			FinishReason string `json:"finish_reason"` // "length" when the output was cut off
+		} `json:"choices"`
+		Usage struct {
+			PromptTokens     int `json:"prompt_tokens"`
+			CompletionTokens int `json:"completion_tokens"`
+		} `json:"usage"`
+	}
+
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		return nil, fmt.Errorf("failed to parse API response: %w", err)
+	}
+
+	if len(apiResp.Choices) == 0 {
+		return nil, fmt.Errorf("no choices in API response")
+	}
+
+	translations, failures := parseTranslations(apiResp.Choices[0].Message.Content, req.Items)
+
+	// Estimated cost from the model's list price (0 for local or unknown models)
+	cost, _ := DefaultPricing.Cost(o.Model, apiResp.Usage.PromptTokens, apiResp.Usage.CompletionTokens)
+	return &TranslationResponse{
+		Success:        true,
+		ItemsProcessed: len(translations),
+		ItemsFailed:    len(failures),
+		Failures:       failures,
+		Translations:   translations,
+		Truncated:      apiResp.Choices[0].FinishReason == "length",
+		Usage: Usage{
+			InputTokens:   apiResp.Usage.PromptTokens,
+			OutputTokens:  apiResp.Usage.CompletionTokens,
+			TotalTokens:   apiResp.Usage.PromptTokens + apiResp.Usage.CompletionTokens,
+			EstimatedCost: cost,
+		},
+	}, nil
+}
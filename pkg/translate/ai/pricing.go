@@ -0,0 +1,79 @@
+package ai
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrBudgetExceeded is returned when a run would spend more than its budget
+var ErrBudgetExceeded = errors.New("AI budget exceeded")
+
+// Price is the cost of a model in USD per million tokens
+type Price struct {
+	Input  float64 `json:"input"`
+	Output float64 `json:"output"`
+}
+
+// PriceTable maps model names (or prefixes, e.g. "claude-3-5-sonnet") to prices
+type PriceTable map[string]Price
+
+// DefaultPricing holds list prices of common models; translate.json ai.pricing
+// adds models and overrides these
+var DefaultPricing = PriceTable{
+	"claude-3-5-sonnet": {Input: 3, Output: 15},
+	"claude-3-7-sonnet": {Input: 3, Output: 15},
+	"claude-sonnet-4":   {Input: 3, Output: 15},
+	"claude-3-5-haiku":  {Input: 0.8, Output: 4},
+	"claude-3-haiku":    {Input: 0.25, Output: 1.25},
+	"claude-3-opus":     {Input: 15, Output: 75},
+	"claude-opus-4":     {Input: 15, Output: 75},
+	"gpt-4o-mini":       {Input: 0.15, Output: 0.6},
+	"gpt-4o":            {Input: 2.5, Output: 10},
+	"dictionary":        {}, // Offline provider
+}
+
+// Merge returns the table with overrides applied on top
+func (t PriceTable) Merge(overrides PriceTable) PriceTable {
+	merged := make(PriceTable, len(t)+len(overrides))
+	for model, price := range t {
+		merged[model] = price
+	}
+	for model, price := range overrides {
+		merged[model] = price
+	}
+	return merged
+}
+
+// Lookup finds the price of a model: an exact entry, else the longest prefix
+// ("claude-3-5-sonnet" prices "claude-3-5-sonnet-20241022")
+func (t PriceTable) Lookup(model string) (Price, bool) {
+	if price, ok := t[model]; ok {
+		return price, true
+	}
+	best, found := "", false
+	for prefix := range t {
+		if strings.HasPrefix(model, prefix) && len(prefix) > len(best) {
+			best, found = prefix, true
+		}
+	}
+	return t[best], found
+}
+
+// Cost returns the USD cost of token usage (0 and false if the model has no price)
+func (t PriceTable) Cost(model string, inputTokens, outputTokens int) (float64, bool) {
+	price, ok := t.Lookup(model)
+	if !ok {
+		return 0, false
+	}
+	return (float64(inputTokens)*price.Input + float64(outputTokens)*price.Output) / 1e6, true
+}
+
+// EstimateRequest estimates the tokens of a request before it is sent:
+// the prompt as input and every item's translation as output
+func EstimateRequest(req *TranslationRequest) (inputTokens int, outputTokens int) {
+	inputTokens = EstimateTokens(buildPrompt(req))
+	for _, item := range req.Items {
+		outputTokens += EstimateOutputTokens(item)
+	}
+	return inputTokens, outputTokens
+}
@@ -0,0 +1,167 @@
+package ai
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// PromptVersion identifies the prompt and response format below
+// Bump it whenever buildPrompt changes so cached responses of the old prompt are not reused
+const PromptVersion = 2
+
+// buildPrompt constructs the translation prompt (shared by every chat provider)
+func buildPrompt(req *TranslationRequest) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("You are a professional translator specializing in %s.\n\n", req.Domain))
+	sb.WriteString(fmt.Sprintf("Translate the following text from %s (%s) to %s (%s).\n\n",
+		req.SourceLanguage, req.SourceLanguage, req.TargetLanguage, req.LanguageName))
+
+	if len(req.Terminology) > 0 {
+		sb.WriteString("TERMINOLOGY (use these exact translations):\n")
+		// Sorted so the same glossary always gives the same prompt (the order does
+		// not change the answer, so PromptVersion stays; the cache keys on the glossary)
+		terms := make([]string, 0, len(req.Terminology))
+		for en := range req.Terminology {
+			terms = append(terms, en)
+		}
+		sort.Strings(terms)
+		for _, en := range terms {
+			sb.WriteString(fmt.Sprintf("- %s → %s\n", en, req.Terminology[en]))
+		}
+		sb.WriteString("\n")
+	}
+
+	if len(req.Notes) > 0 {
+		sb.WriteString("TRANSLATION NOTES:\n")
+		for _, note := range req.Notes {
+			sb.WriteString(fmt.Sprintf("- %s\n", note))
+		}
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("TEXTS TO TRANSLATE:\n")
+	sb.WriteString("Respond with only a JSON object in this exact format, no other text:\n")
+	sb.WriteString("{\"translations\": [\n")
+	sb.WriteString("  {\"id\": \"ID_HERE\", \"target_text\": \"TRANSLATION_HERE\"},\n")
+	sb.WriteString("  ...\n")
+	sb.WriteString("]}\n")
+	sb.WriteString("Return every ID exactly once. Keep placeholders such as ⟦1⟧ unchanged.\n\n")
+
+	sb.WriteString("Items:\n")
+	for _, item := range req.Items {
+		sb.WriteString(fmt.Sprintf("ID: %s\n", item.ID))
+		sb.WriteString(fmt.Sprintf("Context: %s\n", item.Context))
+		sb.WriteString(fmt.Sprintf("Source: %s\n", item.SourceText))
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
+
+// responseSchema is the JSON schema of a response (the tool input schema for Claude)
+var responseSchema = map[string]interface{}{
+	"type": "object",
+	"properties": map[string]interface{}{
+		"translations": map[string]interface{}{
+			"type": "array",
+			"items": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"id":          map[string]interface{}{"type": "string"},
+					"target_text": map[string]interface{}{"type": "string"},
+				},
+				"required": []string{"id", "target_text"},
+			},
+		},
+	},
+	"required": []string{"translations"},
+}
+
+// responsePayload is the JSON object every provider must return
+type responsePayload struct {
+	Translations []struct {
+		ID         string  `json:"id"`
+		TargetText *string `json:"target_text"`
+	} `json:"translations"`
+}
+
+// placeholderPattern matches ⟦n⟧ tokens that must survive translation
+var placeholderPattern = regexp.MustCompile(`⟦[^⟧]*⟧`)
+
+// parseTranslations decodes the JSON object in a text response and validates it
+// Text or code fences around the object are ignored; brackets inside strings are fine
+func parseTranslations(response string, items []TranslationItem) ([]TranslationItem, []ItemFailure) {
+	start := strings.Index(response, "{")
+	if start == -1 {
+		return nil, failAll(items, "no JSON object in response")
+	}
+
+	var payload responsePayload
+	if err := json.NewDecoder(strings.NewReader(response[start:])).Decode(&payload); err != nil {
+		return nil, failAll(items, fmt.Sprintf("invalid JSON response: %v", err))
+	}
+	return validateTranslations(payload, items)
+}
+
+// decodeTranslations validates a structured (tool or JSON mode) response
+func decodeTranslations(raw json.RawMessage, items []TranslationItem) ([]TranslationItem, []ItemFailure) {
+	var payload responsePayload
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return nil, failAll(items, fmt.Sprintf("invalid JSON response: %v", err))
+	}
+	return validateTranslations(payload, items)
+}
+
+// validateTranslations checks that every requested ID came back exactly once
+// with a non-empty translation that keeps the source's placeholders
+// Returns the valid translations and a failure for every other item; unknown IDs are ignored
+func validateTranslations(payload responsePayload, items []TranslationItem) ([]TranslationItem, []ItemFailure) {
+	returned := make(map[string][]*string)
+	for _, t := range payload.Translations {
+		returned[t.ID] = append(returned[t.ID], t.TargetText)
+	}
+
+	var translations []TranslationItem
+	var failures []ItemFailure
+	for _, item := range items {
+		texts := returned[item.ID]
+		reason := ""
+		switch {
+		case len(texts) == 0:
+			reason = "missing from response"
+		case len(texts) > 1:
+			reason = fmt.Sprintf("returned %d times", len(texts))
+		case texts[0] == nil || strings.TrimSpace(*texts[0]) == "":
+			reason = "empty translation"
+		default:
+			for _, token := range placeholderPattern.FindAllString(item.SourceText, -1) {
+				if !strings.Contains(*texts[0], token) {
+					reason = "placeholder " + token + " missing"
+					break
+				}
+			}
+		}
+
+		if reason != "" {
+			failures = append(failures, ItemFailure{ID: item.ID, SourceText: item.SourceText, Reason: reason})
+			continue
+		}
+		translated := item
+		translated.TargetText = *texts[0]
+		translations = append(translations, translated)
+	}
+	return translations, failures
+}
+
+// failAll fails every item for the same reason (the response as a whole was unusable)
+func failAll(items []TranslationItem, reason string) []ItemFailure {
+	failures := make([]ItemFailure, len(items))
+	for i, item := range items {
+		failures[i] = ItemFailure{ID: item.ID, SourceText: item.SourceText, Reason: reason}
+	}
+	return failures
+}
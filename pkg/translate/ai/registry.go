@@ -0,0 +1,113 @@
+package ai
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Config selects and configures a translation provider (the "ai" section of translate.json)
+type Config struct {
+	Provider   string `json:"provider"`    // Registered provider name (default: "claude")
+	Model      string `json:"model"`       // Provider default when empty
+	Endpoint   string `json:"endpoint"`    // API URL, e.g. http://localhost:11434/v1 for Ollama
+	APIKeyEnv  string `json:"api_key_env"` // Environment variable holding the key (provider default when empty)
+	Dictionary string `json:"dictionary"`  // Dictionary provider: JSON file of source → target ({language} = target language)
+	APIKey     string `json:"-"`           // Explicit key (e.g. --api-key=), wins over APIKeyEnv
+
+	TimeoutSeconds int `json:"timeout_seconds"` // Per HTTP request (default: 120)
+	MaxRetries     int `json:"max_retries"`     // Retries of rate-limited or failed requests (default: 4, negative = never)
+
+	MaxOutputTokens int `json:"max_output_tokens"` // Output limit of one response (default: DefaultMaxOutputTokens)
+}
+
+// maxOutputTokens returns the output limit of one response
+func (cfg Config) maxOutputTokens() int {
+	if cfg.MaxOutputTokens > 0 {
+		return cfg.MaxOutputTokens
+	}
+	return DefaultMaxOutputTokens
+}
+
+// timeout returns the per-request timeout
+func (cfg Config) timeout() time.Duration {
+	if cfg.TimeoutSeconds > 0 {
+		return time.Duration(cfg.TimeoutSeconds) * time.Second
+	}
+	return DefaultTimeout
+}
+
+// retryPolicy returns DefaultRetryPolicy with the configured number of retries
+func (cfg Config) retryPolicy() RetryPolicy {
+	policy := DefaultRetryPolicy
+	if cfg.MaxRetries > 0 {
+		policy.MaxRetries = cfg.MaxRetries
+	} else if cfg.MaxRetries < 0 {
+		policy.MaxRetries = 0
+	}
+	return policy
+}
+
+// Factory creates a translator from its configuration
+type Factory func(cfg Config) (Translator, error)
+
+var providers = map[string]Factory{}
+
+// Register makes a provider available to New under a name
+func Register(name string, factory Factory) {
+	providers[name] = factory
+}
+
+// Providers returns the registered provider names in sorted order
+func Providers() []string {
+	names := make([]string, 0, len(providers))
+	for name := range providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// New creates the translator named by cfg.Provider
+// Single entry point for choosing a translation provider
+func New(cfg Config) (Translator, error) {
+	if cfg.Provider == "" {
+		cfg.Provider = "claude"
+	}
+	factory, ok := providers[cfg.Provider]
+	if !ok {
+		return nil, fmt.Errorf("unknown AI provider %q (available: %s)", cfg.Provider, strings.Join(Providers(), ", "))
+	}
+	if cfg.APIKey == "" && cfg.APIKeyEnv != "" {
+		cfg.APIKey = os.Getenv(cfg.APIKeyEnv)
+	}
+	return factory(cfg)
+}
+
+func init() {
+	Register("claude", func(cfg Config) (Translator, error) {
+		translator := NewClaudeTranslator(cfg.APIKey, cfg.Model)
+		if translator.APIKey == "" {
+			return nil, fmt.Errorf("Claude API key not set (use ANTHROPIC_API_KEY env var)")
+		}
+		if cfg.Endpoint != "" {
+			translator.Endpoint = cfg.Endpoint
+		}
+		translator.client.Timeout = cfg.timeout()
+		translator.Retry = cfg.retryPolicy()
+		translator.MaxTokens = cfg.maxOutputTokens()
+		return translator, nil
+	})
+	Register("openai", func(cfg Config) (Translator, error) {
+		translator := NewOpenAITranslator(cfg.APIKey, cfg.Model, cfg.Endpoint)
+		translator.client.Timeout = cfg.timeout()
+		translator.Retry = cfg.retryPolicy()
+		translator.MaxTokens = cfg.maxOutputTokens()
+		return translator, nil
+	})
+	Register("dictionary", func(cfg Config) (Translator, error) {
+		return NewDictionaryTranslator(cfg.Dictionary), nil
+	})
+}
@@ -0,0 +1,158 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how failed API requests are retried
+type RetryPolicy struct {
+	MaxRetries int           // Retries after the first attempt (0 = never retry)
+	BaseDelay  time.Duration // First backoff; doubles per retry
+	MaxDelay   time.Duration // Cap of one backoff (a longer retry-after still wins)
+}
+
+// DefaultTimeout bounds one HTTP request (a batch of up to 4096 output tokens)
+const DefaultTimeout = 120 * time.Second
+
+// DefaultRetryPolicy retries 4 times with backoff from 2s up to 60s
+var DefaultRetryPolicy = RetryPolicy{MaxRetries: 4, BaseDelay: 2 * time.Second, MaxDelay: 60 * time.Second}
+
+// APIError is a non-200 response from a provider
+type APIError struct {
+	Provider   string
+	StatusCode int
+	RetryAfter time.Duration // From the retry-after header (0 if absent)
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s API error (status %d): %s", e.Provider, e.StatusCode, e.Body)
+}
+
+// Retryable reports whether the request may succeed when sent again:
+// rate limits (429), overload (529) and transient server errors
+func (e *APIError) Retryable() bool {
+	switch e.StatusCode {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout, 529:
+		return true
+	}
+	return false
+}
+
+// FailureReason classifies a translation error for events and messages:
+// "budget", "interrupted", "timeout", "rate_limited", "api_error" or "error"
+func FailureReason(err error) string {
+	var apiErr *APIError
+	var netErr net.Error
+	switch {
+	case errors.Is(err, ErrBudgetExceeded):
+		return "budget"
+	case errors.Is(err, context.Canceled):
+		return "interrupted"
+	case errors.Is(err, context.DeadlineExceeded), errors.As(err, &netErr) && netErr.Timeout():
+		return "timeout"
+	case errors.As(err, &apiErr) && (apiErr.StatusCode == http.StatusTooManyRequests || apiErr.StatusCode == 529):
+		return "rate_limited"
+	case errors.As(err, &apiErr):
+		return "api_error"
+	}
+	return "error"
+}
+
+// send performs an HTTP request with retries and returns the body of the 200 response
+// newRequest is called per attempt (a request body can only be read once)
+func send(ctx context.Context, client *http.Client, policy RetryPolicy, provider string, newRequest func(ctx context.Context) (*http.Request, error)) ([]byte, error) {
+	for attempt := 0; ; attempt++ {
+		body, err := sendOnce(ctx, client, provider, newRequest)
+		if err == nil {
+			return body, nil
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err() // Cancelled (Ctrl-C) or the caller's deadline
+		}
+
+		var apiErr *APIError
+		retryable := !errors.As(err, &apiErr) || apiErr.Retryable() // Network errors are retried too
+		if !retryable || attempt >= policy.MaxRetries {
+			return nil, err
+		}
+
+		delay := policy.backoff(attempt)
+		if apiErr != nil && apiErr.RetryAfter > delay {
+			delay = apiErr.RetryAfter
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func sendOnce(ctx context.Context, client *http.Client, provider string, newRequest func(ctx context.Context) (*http.Request, error)) ([]byte, error) {
+	httpReq, err := newRequest(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP request: %w", err)
+	}
+
+	resp, err := client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call %s API: %w", provider, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read API response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &APIError{
+			Provider:   provider,
+			StatusCode: resp.StatusCode,
+			RetryAfter: parseRetryAfter(resp.Header.Get("retry-after")),
+			Body:       string(body),
+		}
+	}
+	return body, nil
+}
+
+// backoff is the exponential delay before retry number attempt+1, jittered
+// between half and all of it so parallel workers do not retry in lockstep
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.BaseDelay << attempt
+	if delay <= 0 || delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+// parseRetryAfter reads a retry-after header in seconds or as an HTTP date
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.ParseFloat(value, 64); err == nil && seconds > 0 {
+		return time.Duration(seconds * float64(time.Second))
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		if delay := time.Until(at); delay > 0 {
+			return delay
+		}
+	}
+	return 0
+}
@@ -1,5 +1,7 @@
 package ai
 
+import "context"
+
 // TranslationRequest represents a batch translation request to AI
 type TranslationRequest struct {
 	SourceLanguage string              `json:"source_language"`
@@ -19,11 +21,23 @@ type TranslationItem struct {
 	TargetText  string `json:"target_text"`  // Filled by AI
 }
 
+// ItemFailure is a requested item without a valid translation in the response
+type ItemFailure struct {
+	ID         string `json:"id"`
+	SourceText string `json:"source_text"`
+	Reason     string `json:"reason"` // "missing from response", "returned 2 times", "empty translation", ...
+}
+
 // TranslationResponse represents the AI's translation response
 type TranslationResponse struct {
 	Success        bool              `json:"success"`
-	ItemsProcessed int               `json:"items_processed"`
+	ItemsProcessed int               `json:"items_processed"` // Items with a valid translation
+	ItemsFailed    int               `json:"items_failed,omitempty"`   // Items sent that did not come back valid
+	ItemsSkipped   int               `json:"items_skipped,omitempty"`  // Items never sent (run stopped early)
+	Failures       []ItemFailure     `json:"failures,omitempty"`
+	CacheHits      int               `json:"cache_hits,omitempty"` // Items filled from the local AI cache (not sent)
 	Translations   []TranslationItem `json:"translations"`
+	Truncated      bool              `json:"truncated,omitempty"` // Output stopped at max_tokens (the batch must be split)
 	Error          string            `json:"error,omitempty"`
 	Usage          Usage             `json:"usage"`
 }
@@ -39,7 +53,8 @@ type Usage struct {
 // Translator is the interface for AI translation services
 type Translator interface {
 	// Translate performs batch translation
-	Translate(req *TranslationRequest) (*TranslationResponse, error)
+	// Cancelling ctx (e.g. Ctrl-C) aborts the request and any pending retries
+	Translate(ctx context.Context, req *TranslationRequest) (*TranslationResponse, error)
 
 	// Name returns the translator name (e.g., "claude-3-5-sonnet")
 	Name() string
@@ -1,11 +1,12 @@
 package translate
 
 import (
-	"bufio"
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 )
 
@@ -46,13 +47,20 @@ func ValidateTask(task *Task) *ApplyStats {
 
 // ApplyTranslations applies translations from a task to target files
 // Single entry point for applying translations
-func ApplyTranslations(rootDir string, task *Task) (*ApplyStats, error) {
+// config must select the same SVG elements and attributes as when the task was generated
+func ApplyTranslations(rootDir string, task *Task, config *Config) (*ApplyStats, error) {
 	stats := ValidateTask(task)
 
 	if stats.FilledExtractions == 0 {
 		return stats, fmt.Errorf("no translations found in task file (all target_text fields are empty)")
 	}
 
+	// Typography measures labels for svg.wrap_width
+	typography, err := LoadTypography(filepath.Join(rootDir, config.Paths.Standards))
+	if err != nil {
+		return stats, err
+	}
+
 	// Apply translations to each file
 	for _, file := range task.Files {
 		// Count filled extractions for this file
@@ -71,11 +79,20 @@ func ApplyTranslations(rootDir string, task *Task) (*ApplyStats, error) {
 		targetPath := filepath.Join(rootDir, file.Target)
 
 		// Apply based on file type
+		var applied int
+		var unresolved []UnresolvedExtraction
 		var applyErr error
 		if file.Type == "svg" {
-			applyErr = applySVGTranslations(targetPath, file.Extractions)
+			applied, unresolved, applyErr = applySVGTranslations(targetPath, file.Extractions, config.SVG, typography)
 		} else if file.Type == "md" || file.Type == "markdown" {
-			applyErr = applyMarkdownTranslations(targetPath, file.Extractions)
+			applied, unresolved, applyErr = applyMarkdownTranslations(targetPath, file.Extractions)
+		} else if file.Type == "json" {
+			doc, ok := findJSONDocument(config, file.Target, task.TargetLanguage)
+			if !ok {
+				stats.FilesSkipped++ // No longer listed in json_documents
+				continue
+			}
+			applied, unresolved, applyErr = applyJSONTranslations(targetPath, file.Extractions, doc.Paths)
 		} else {
 			stats.FilesSkipped++
 			continue
@@ -83,8 +100,14 @@ func ApplyTranslations(rootDir string, task *Task) (*ApplyStats, error) {
 
 		if applyErr != nil {
 			stats.FilesSkipped++
-		} else {
-			stats.FilesProcessed++
+			continue
+		}
+
+		stats.FilesProcessed++
+		stats.AppliedExtractions += applied
+		for _, u := range unresolved {
+			u.File = file.Target
+			stats.Unresolved = append(stats.Unresolved, u)
 		}
 	}
 
@@ -102,63 +125,196 @@ func DeleteTask(rootDir string, taskFile string) error {
 }
 
 // applySVGTranslations applies translations to an SVG file
-func applySVGTranslations(filePath string, extractions []TextExtraction) error {
-	// Read the entire SVG file
+// Text nodes are located by extraction ID, never by searching for the source text
+// Multi-line units are written back across the same <tspan> lines; with svg.wrap_width
+// a single-line <text> whose translation is wider is split into <tspan> lines
+func applySVGTranslations(filePath string, extractions []TextExtraction, svg SVGConfig, typography *Typography) (int, []UnresolvedExtraction, error) {
 	data, err := os.ReadFile(filePath)
 	if err != nil {
-		return err
+		return 0, nil, err
 	}
 
-	content := string(data)
+	nodes, err := scanSVGTextNodes(data, svg)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to parse SVG: %w", err)
+	}
 
-	// Apply each translation by replacing source text with target text
-	for _, ext := range extractions {
-		if ext.TargetText == "" {
-			continue // Skip empty translations
+	render := renderSVGText
+	if svg.WrapWidth > 0 {
+		layout, err := scanSVGLayout(data, typography)
+		if err != nil {
+			return 0, nil, fmt.Errorf("failed to parse SVG: %w", err)
 		}
-
-		// Replace the source text with target text
-		// This is a simple string replacement approach
-		content = strings.Replace(content, ">"+ext.SourceText+"<", ">"+ext.TargetText+"<", 1)
+		render = wrappingSVGRender(data, layout, svg.WrapWidth, typography.LineHeight)
 	}
 
+	output, applied, unresolved := applyToNodes(data, nodes, extractions, render)
+
 	// Write back to file
-	return os.WriteFile(filePath, []byte(content), 0644)
+	return applied, unresolved, os.WriteFile(filePath, output, 0644)
 }
 
 // applyMarkdownTranslations applies translations to a Markdown file
-func applyMarkdownTranslations(filePath string, extractions []TextExtraction) error {
-	// Read the file line by line
-	file, err := os.Open(filePath)
+// Units are located by extraction ID, so the file may drift without misplacing text;
+// only the unit's inline text is replaced, block markers (#, -, >, |) stay as they are
+func applyMarkdownTranslations(filePath string, extractions []TextExtraction) (int, []UnresolvedExtraction, error) {
+	data, err := os.ReadFile(filePath)
 	if err != nil {
-		return err
+		return 0, nil, err
 	}
-	defer file.Close()
 
-	var lines []string
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		lines = append(lines, scanner.Text())
+	nodes, err := scanMarkdownTextNodes(data)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to parse Markdown: %w", err)
 	}
 
-	if err := scanner.Err(); err != nil {
-		return err
+	output, applied, unresolved := applyToNodes(data, nodes, extractions, renderMarkdownText)
+
+	// Write back to file
+	return applied, unresolved, os.WriteFile(filePath, output, 0644)
+}
+
+// applyToNodes resolves each filled extraction to a text node and splices in its translation
+// Resolution order:
+//  1. node at the ID's path whose text still hashes to the ID's source hash
+//  2. node at the ID's path that already holds the translation (re-apply is a no-op,
+//     also after the translation was wrapped into lines)
+//  3. the single unclaimed node anywhere in the file with the same source hash
+//
+// Anything else, or a translation that render rejects, is reported as unresolved and left untouched
+func applyToNodes(data []byte, nodes []textNode, extractions []TextExtraction, render func(node textNode, translation string) ([]string, error)) ([]byte, int, []UnresolvedExtraction) {
+	byPath := make(map[string]int)
+	byHash := make(map[string][]int)
+	for i, node := range nodes {
+		byPath[node.Path] = i
+		byHash[sourceHash(node.Text)] = append(byHash[sourceHash(node.Text)], i)
 	}
 
-	// Apply translations by line number
+	claimed := make(map[int][]string) // node index -> rendered replacement per span
+	applied := 0
+	var unresolved []UnresolvedExtraction
+
 	for _, ext := range extractions {
 		if ext.TargetText == "" {
 			continue // Skip empty translations
 		}
 
-		// Line numbers are 1-indexed
-		if ext.Line > 0 && ext.Line <= len(lines) {
-			// Replace the entire line with the translated text
-			lines[ext.Line-1] = ext.TargetText
+		report := func(reason string) {
+			unresolved = append(unresolved, UnresolvedExtraction{
+				ID:         ext.ID,
+				Line:       ext.Line,
+				SourceText: ext.SourceText,
+				Reason:     reason,
+			})
+		}
+
+		if ext.ID == "" {
+			report("extraction has no id (regenerate the task with translate sync)")
+			continue
+		}
+
+		path, hash := splitExtractionID(ext.ID)
+
+		// Find the node this extraction belongs to
+		target := -1
+		reason := ""
+		if idx, ok := byPath[path]; ok {
+			if _, taken := claimed[idx]; !taken {
+				if sourceHash(nodes[idx].Text) == hash {
+					target = idx
+				} else if sameText(nodes[idx].Text, ext.TargetText) {
+					applied++ // Already translated
+					continue
+				}
+			}
+			reason = "text at path has changed since extraction"
+		}
+
+		if target == -1 {
+			// Fall back to a unique match on the source hash (element moved in the target)
+			var candidates []int
+			for _, idx := range byHash[hash] {
+				if _, taken := claimed[idx]; !taken {
+					candidates = append(candidates, idx)
+				}
+			}
+			if len(candidates) == 1 {
+				target = candidates[0]
+			} else if len(candidates) > 1 {
+				reason = fmt.Sprintf("id is ambiguous (%d nodes with the same source text)", len(candidates))
+			} else if reason == "" {
+				reason = "no text node found for id"
+			}
+		}
+
+		if target == -1 {
+			report(reason)
+			continue
 		}
+
+		replacements, err := render(nodes[target], ext.TargetText)
+		if err != nil {
+			report(err.Error())
+			continue
+		}
+		claimed[target] = replacements
+		applied++
 	}
 
-	// Write back to file
-	output := strings.Join(lines, "\n") + "\n"
-	return os.WriteFile(filePath, []byte(output), 0644)
+	// Splice translations in from the end so earlier offsets stay valid
+	type splice struct {
+		span        textSpan
+		replacement string
+	}
+	var splices []splice
+	for idx, replacements := range claimed {
+		for i, span := range nodes[idx].Spans {
+			splices = append(splices, splice{span: span, replacement: replacements[i]})
+		}
+	}
+	sort.Slice(splices, func(i, j int) bool {
+		return splices[i].span.Start > splices[j].span.Start
+	})
+
+	output := append([]byte(nil), data...)
+	for _, sp := range splices {
+		output = append(output[:sp.span.Start], append([]byte(sp.replacement), output[sp.span.End:]...)...)
+	}
+
+	return output, applied, unresolved
+}
+
+// wrappingSVGRender renders SVG translations, splitting a <text> that holds plain
+// text (no <tspan>s) into <tspan> lines when its translation is wider than maxWidth
+func wrappingSVGRender(data []byte, layout *svgLayout, maxWidth, lineHeight float64) func(node textNode, translation string) ([]string, error) {
+	labels := make(map[string]*svgLabel)
+	for _, label := range layout.Labels {
+		labels[label.Path] = label
+	}
+
+	return func(node textNode, translation string) ([]string, error) {
+		label, ok := labels[node.Path]
+		if !ok || len(node.Spans) != 1 || len(bytes.TrimSpace(data[label.content[0]:node.Spans[0].Start])) > 0 {
+			return renderSVGText(node, translation)
+		}
+		if label.measure(translation) <= maxWidth {
+			return renderSVGText(node, translation)
+		}
+
+		rows := wrapText(translation, maxWidth, label.measure)
+		if len(rows) < 2 {
+			return renderSVGText(node, translation)
+		}
+		tspans, err := renderTspans(rows, label.Lines[0].X, lineHeight)
+		if err != nil {
+			return nil, err
+		}
+		return []string{tspans}, nil
+	}
+}
+
+// sameText reports whether two texts are equal ignoring whitespace (wrapped lines
+// are joined with spaces when read back)
+func sameText(a, b string) bool {
+	return strings.Join(strings.Fields(a), "") == strings.Join(strings.Fields(b), "")
 }
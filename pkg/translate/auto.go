@@ -1,17 +1,76 @@
 package translate
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"os"
 	"path/filepath"
+	"sync"
 
 	"github.com/joeblew999/mon-house/pkg/translate/ai"
 )
 
+// NewTranslator creates the AI provider selected by the ai section of translate.json
+// A relative dictionary path is resolved against rootDir
+func NewTranslator(rootDir string, config AIConfig) (ai.Translator, error) {
+	cfg := config.Config
+	if cfg.Dictionary != "" && !filepath.IsAbs(cfg.Dictionary) {
+		cfg.Dictionary = filepath.Join(rootDir, cfg.Dictionary)
+	}
+	return ai.New(cfg)
+}
+
+// itemRetries is how often the items missing or invalid in a response are re-sent
+const itemRetries = 2
+
+// AutoOptions controls how AutoTranslate splits the work into AI requests
+type AutoOptions struct {
+	BatchTokens int                      // Estimated output tokens per request (default: ai.DefaultBatchTokens)
+	Workers     int                      // Requests in flight at once (default: 1)
+	OnBatch     func(BatchResult)        // Called after each batch, one call at a time (optional)
+	Cache       *AICache                 // Cached responses are used instead of calling the provider (optional)
+	Provider    string                   // Provider name, part of the cache key
+	Pricing     ai.PriceTable            // Prices for cost estimates (default: ai.DefaultPricing)
+	BudgetUSD   float64                  // Most this run may spend (0 = no limit)
+	Preflight   func(AutoEstimate) error // Called with the estimate before any request; an error aborts (optional)
+}
+
+// AutoEstimate is the expected size and cost of an AutoTranslate run
+type AutoEstimate struct {
+	Items        int // Items to send (after cache hits)
+	CacheHits    int
+	Batches      int
+	InputTokens  int
+	OutputTokens int
+	CostUSD      float64
+	Priced       bool // False if the model has no price (cost unknown, budget not enforced)
+}
+
+// BatchResult reports one finished (or failed) batch of an AutoTranslate run
+type BatchResult struct {
+	Index      int // 1-based
+	Total      int
+	Items      int // Items sent
+	Translated int // Items that came back with a valid translation
+	Retries    int // Requests re-sending missing or invalid items
+	Failures   []ai.ItemFailure
+	Usage      ai.Usage
+	Err        error
+}
+
 // AutoTranslate uses AI to automatically fill in translations (HEADLESS mode)
 // This enables fully automated translation without human intervention
-func AutoTranslate(rootDir string, taskFile string, translator ai.Translator) (*Task, *ai.TranslationResponse, error) {
+// The glossary's translated terms are sent as terminology; glossary may be nil
+//
+// Empty extractions are split into batches sized by an estimated token budget
+// and translated by up to options.Workers concurrent requests. Results are
+// merged by item ID and the task file is saved after every batch, so a failed
+// or interrupted run keeps what was already translated (a re-run only sends
+// the extractions that are still empty)
+// With options.Cache, items answered before by the same provider, model, prompt
+// and glossary are filled from the cache (counted in CacheHits, not sent)
+// Cancelling ctx stops the requests in flight and starts no new ones
+func AutoTranslate(ctx context.Context, rootDir string, taskFile string, translator ai.Translator, glossary *Glossary, options AutoOptions) (*Task, *ai.TranslationResponse, error) {
 	// Step 1: Load the task file
 	task, err := LoadTask(rootDir, taskFile)
 	if err != nil {
@@ -23,65 +82,343 @@ func AutoTranslate(rootDir string, taskFile string, translator ai.Translator) (*
 		return nil, nil, fmt.Errorf("task has no files to translate")
 	}
 
-	// Step 3: Build translation request for AI
-	req := &ai.TranslationRequest{
+	// Step 3: Build the shared part of every request
+	base := ai.TranslationRequest{
 		SourceLanguage: task.SourceLanguage,
 		TargetLanguage: task.TargetLanguage,
 		LanguageName:   task.LanguageName,
 		Domain:         "architectural drawings",
 		Notes:          task.TranslationNotes,
-		Items:          []ai.TranslationItem{},
 	}
 
-	// Extract terminology from notes if present
-	// TODO: Parse this from translate.json or drawing-standards.json
-	req.Terminology = map[string]string{
-		"envelope":      "แนวเปลือกอาคาร",
-		"wall-exterior": "ผนังภายนอก",
-		"roof":          "หลังคา",
-		"foundation":    "ฐานราก",
+	// Project terminology from the target language glossary
+	if glossary != nil {
+		base.Terminology = glossary.Terminology()
 	}
 
 	// Collect all items that need translation
-	itemID := 0
-	fileItemMap := make(map[string][]int) // maps item ID to [fileIdx, extIdx]
+	var items []ai.TranslationItem
+	fileItemMap := make(map[string][]int)          // maps item ID to [fileIdx, extIdx]
+	itemMap := make(map[string]ai.TranslationItem) // maps item ID to the item sent
 
 	for fileIdx, file := range task.Files {
 		for extIdx, ext := range file.Extractions {
 			if ext.TargetText == "" {
-				itemIDStr := fmt.Sprintf("%d", itemID)
-				req.Items = append(req.Items, ai.TranslationItem{
+				itemIDStr := fmt.Sprintf("%d", len(items))
+				items = append(items, ai.TranslationItem{
 					ID:         itemIDStr,
 					Context:    ext.Context,
 					SourceText: ext.SourceText,
 					TargetText: "",
 				})
 				fileItemMap[itemIDStr] = []int{fileIdx, extIdx}
-				itemID++
+				itemMap[itemIDStr] = items[len(items)-1]
 			}
 		}
 	}
 
-	if len(req.Items) == 0 {
+	if len(items) == 0 {
 		return task, nil, fmt.Errorf("all translations already filled (nothing to do)")
 	}
 
-	// Step 4: Call AI translator (HEADLESS - fully automated)
-	resp, err := translator.Translate(req)
-	if err != nil {
-		return nil, nil, fmt.Errorf("AI translation failed: %w", err)
+	// Step 4: Fill items from the AI cache (no provider call)
+	total := &ai.TranslationResponse{Success: true}
+	scope := CacheScope{
+		Provider:       options.Provider,
+		Model:          translator.Name(),
+		GlossaryHash:   GlossaryHash(base.Terminology),
+		SourceLanguage: task.SourceLanguage,
+		TargetLanguage: task.TargetLanguage,
+	}
+	if options.Cache != nil {
+		var uncached []ai.TranslationItem
+		for _, item := range items {
+			if target, ok := options.Cache.Lookup(scope, item); ok {
+				indices := fileItemMap[item.ID]
+				setMachineTranslation(&task.Files[indices[0]].Extractions[indices[1]], target)
+				total.CacheHits++
+			} else {
+				uncached = append(uncached, item)
+			}
+		}
+		items = uncached
+
+		if total.CacheHits > 0 {
+			if err := SaveTask(rootDir, taskFile, task); err != nil {
+				return nil, nil, err
+			}
+		}
+		if len(items) == 0 {
+			if err := options.Cache.Save(); err != nil {
+				return task, total, fmt.Errorf("failed to save AI cache: %w", err)
+			}
+			return task, total, nil
+		}
+	}
+
+	// Step 5: Split into batches by estimated token budget (which must fit in one response)
+	if err := ai.CheckBatchTokens(translator, options.BatchTokens); err != nil {
+		return task, total, err
+	}
+	batches := ai.Batches(items, options.BatchTokens)
+	pricing := options.Pricing
+	if pricing == nil {
+		pricing = ai.DefaultPricing
+	}
+
+	// Step 6: Preflight - estimate the cost and check the budget before sending anything
+	estimate := AutoEstimate{Items: len(items), CacheHits: total.CacheHits, Batches: len(batches)}
+	batchCosts := make([]float64, len(batches))
+	for index, batch := range batches {
+		req := base
+		req.Items = batch
+		input, output := ai.EstimateRequest(&req)
+		estimate.InputTokens += input
+		estimate.OutputTokens += output
+		batchCosts[index], estimate.Priced = pricing.Cost(translator.Name(), input, output)
+		estimate.CostUSD += batchCosts[index]
+	}
+	if options.Preflight != nil {
+		if err := options.Preflight(estimate); err != nil {
+			return task, total, err
+		}
+	}
+	if options.BudgetUSD > 0 && estimate.CostUSD > options.BudgetUSD {
+		return task, total, fmt.Errorf("%w: estimated $%.4f for %d items, budget $%.4f",
+			ai.ErrBudgetExceeded, estimate.CostUSD, estimate.Items, options.BudgetUSD)
+	}
+
+	workers := options.Workers
+	if workers <= 0 {
+		workers = 1
+	}
+	if workers > len(batches) {
+		workers = len(batches)
+	}
+
+	// Step 7: Call AI translator per batch (HEADLESS - fully automated)
+	// Merging and saving happen under mu, so the task file is always whole
+	var (
+		mu       sync.Mutex
+		firstErr error
+		failed   bool
+		reserved float64 // Estimated cost of the batches in flight
+	)
+
+	queue := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range queue {
+				mu.Lock()
+				stop := failed || ctx.Err() != nil
+				// Stop before a batch that could take the run over budget (actual costs can exceed the estimate)
+				if !stop && options.BudgetUSD > 0 && total.Usage.EstimatedCost+reserved+batchCosts[index] > options.BudgetUSD {
+					failed = true
+					firstErr = fmt.Errorf("%w: spent $%.4f, next batch ~$%.4f, budget $%.4f",
+						ai.ErrBudgetExceeded, total.Usage.EstimatedCost+reserved, batchCosts[index], options.BudgetUSD)
+					stop = true
+				}
+				if !stop {
+					reserved += batchCosts[index]
+				}
+				if stop {
+					total.ItemsSkipped += len(batches[index])
+				}
+				mu.Unlock()
+				if stop {
+					continue // Don't start new requests after a failure, cancellation or budget stop
+				}
+
+				batch := translateBatch(ctx, translator, base, batches[index], pricing)
+
+				mu.Lock()
+				reserved -= batchCosts[index]
+				result := BatchResult{
+					Index:    index + 1,
+					Total:    len(batches),
+					Items:    len(batches[index]),
+					Retries:  batch.retries,
+					Failures: batch.failures,
+					Usage:    batch.usage,
+					Err:      batch.err,
+				}
+
+				// Step 8: Merge translations back into the task by ID (and into the cache)
+				for _, item := range batch.translations {
+					if indices, ok := fileItemMap[item.ID]; ok {
+						setMachineTranslation(&task.Files[indices[0]].Extractions[indices[1]], item.TargetText)
+						result.Translated++
+						if options.Cache != nil {
+							options.Cache.Add(scope, itemMap[item.ID], item.TargetText)
+						}
+					}
+				}
+				addUsage(&total.Usage, batch.usage)
+				total.ItemsProcessed += result.Translated
+				total.ItemsFailed += result.Items - result.Translated
+				total.Failures = append(total.Failures, batch.failures...)
+
+				// Step 9: Save after each batch so a crash loses at most the batches in flight
+				if result.Translated > 0 {
+					if err := SaveTask(rootDir, taskFile, task); err != nil && result.Err == nil {
+						result.Err = err
+					}
+					if options.Cache != nil {
+						options.Cache.Save() // Best effort here; the final save reports errors
+					}
+				}
+				if result.Err != nil && !failed {
+					failed = true
+					firstErr = fmt.Errorf("batch %d of %d: %w", result.Index, result.Total, result.Err)
+				}
+				if options.OnBatch != nil {
+					options.OnBatch(result)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	for index := range batches {
+		queue <- index
+	}
+	close(queue)
+	wg.Wait()
+
+	if options.Cache != nil {
+		if err := options.Cache.Save(); err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("failed to save AI cache: %w", err)
+		}
 	}
+	if firstErr == nil && ctx.Err() != nil {
+		firstErr = ctx.Err() // Cancelled between batches
+	}
+	if firstErr != nil {
+		total.Success = false
+		total.Error = firstErr.Error()
+		return task, total, fmt.Errorf("AI translation failed (%d items saved): %w", total.ItemsProcessed, firstErr)
+	}
+	return task, total, nil
+}
+
+// batchOutcome is what translateBatch got for one batch over all its attempts
+type batchOutcome struct {
+	translations []ai.TranslationItem
+	failures     []ai.ItemFailure // Items still without a valid translation
+	usage        ai.Usage
+	retries      int
+	err          error // Request error (the items of the failed attempt are not in failures)
+}
+
+// translateBatch sends a batch and re-sends only the items that came back
+// missing or invalid, up to itemRetries more times
+// A response cut off at max_tokens is not re-sent as is: the pending items are
+// split in half and each half is translated on its own. Items too long for one
+// response of the translator fail without being sent
+func translateBatch(ctx context.Context, translator ai.Translator, base ai.TranslationRequest, items []ai.TranslationItem, pricing ai.PriceTable) batchOutcome {
+	var outcome batchOutcome
+	var pending []ai.TranslationItem
+	limit := ai.OutputLimit(translator)
+	for _, item := range items {
+		if estimate := ai.EstimateOutputTokens(item); limit > 0 && estimate > limit {
+			outcome.failures = append(outcome.failures, ai.ItemFailure{ID: item.ID, SourceText: item.SourceText,
+				Reason: fmt.Sprintf("too long for one response (~%d output tokens, limit %d)", estimate, limit)})
+			continue
+		}
+		pending = append(pending, item)
+	}
+	tooLong := outcome.failures[:len(outcome.failures):len(outcome.failures)]
+
+	for attempt := 0; attempt <= itemRetries && len(pending) > 0; attempt++ {
+		if attempt > 0 {
+			outcome.retries++
+		}
+
+		req := base
+		req.Items = pending
+		resp, err := translator.Translate(ctx, &req)
+		if err != nil {
+			outcome.err = err
+			return outcome
+		}
+
+		// Cost from the configured price table (the provider only knows list prices)
+		if cost, ok := pricing.Cost(translator.Name(), resp.Usage.InputTokens, resp.Usage.OutputTokens); ok {
+			resp.Usage.EstimatedCost = cost
+		}
+		addUsage(&outcome.usage, resp.Usage)
+
+		// Cut off at max_tokens: translate each half separately (the whole batch would be cut off again)
+		if resp.Truncated {
+			if len(pending) == 1 {
+				outcome.failures = append(tooLong, ai.ItemFailure{ID: pending[0].ID, SourceText: pending[0].SourceText,
+					Reason: "response cut off at max_tokens"})
+				return outcome
+			}
+			outcome.failures = tooLong
+			half := len(pending) / 2
+			for _, part := range [][]ai.TranslationItem{pending[:half], pending[half:]} {
+				split := translateBatch(ctx, translator, base, part, pricing)
+				outcome.translations = append(outcome.translations, split.translations...)
+				outcome.failures = append(outcome.failures, split.failures...)
+				addUsage(&outcome.usage, split.usage)
+				outcome.retries += split.retries + 1
+				if split.err != nil {
+					outcome.err = split.err
+					return outcome
+				}
+			}
+			return outcome
+		}
 
-	// Step 5: Apply translations back to task
-	for _, item := range resp.Translations {
-		if indices, ok := fileItemMap[item.ID]; ok {
-			fileIdx := indices[0]
-			extIdx := indices[1]
-			task.Files[fileIdx].Extractions[extIdx].TargetText = item.TargetText
+		valid := make(map[string]bool)
+		for _, item := range resp.Translations {
+			if item.TargetText != "" {
+				outcome.translations = append(outcome.translations, item)
+				valid[item.ID] = true
+			}
+		}
+		reasons := make(map[string]string)
+		for _, failure := range resp.Failures {
+			reasons[failure.ID] = failure.Reason
 		}
+
+		var retry []ai.TranslationItem
+		outcome.failures = tooLong
+		for _, item := range pending {
+			if valid[item.ID] {
+				continue
+			}
+			reason, ok := reasons[item.ID]
+			if !ok {
+				reason = "missing from response"
+			}
+			retry = append(retry, item)
+			outcome.failures = append(outcome.failures, ai.ItemFailure{ID: item.ID, SourceText: item.SourceText, Reason: reason})
+		}
+		pending = retry
 	}
+	return outcome
+}
+
+// addUsage adds one request's usage to a running total
+func addUsage(total *ai.Usage, usage ai.Usage) {
+	total.InputTokens += usage.InputTokens
+	total.OutputTokens += usage.OutputTokens
+	total.TotalTokens += usage.TotalTokens
+	total.EstimatedCost += usage.EstimatedCost
+}
 
-	return task, resp, nil
+// setMachineTranslation fills an extraction with AI output, which needs review
+func setMachineTranslation(ext *TextExtraction, targetText string) {
+	ext.TargetText = targetText
+	ext.Status = StatusMachine
+	ext.Reviewer = ""
+	ext.ReviewedAt = nil
+	ext.BackTranslation = ""
+	ext.Drift = 0
 }
 
 // SaveTask saves a task back to its JSON file
@@ -93,7 +430,8 @@ func SaveTask(rootDir string, taskFile string, task *Task) error {
 		return fmt.Errorf("failed to marshal task: %w", err)
 	}
 
-	if err := os.WriteFile(fullPath, jsonData, 0644); err != nil {
+	// Written once per batch: replace the file whole so an interrupted write never leaves half a task
+	if err := writeFileAtomic(fullPath, jsonData); err != nil {
 		return fmt.Errorf("failed to write task file: %w", err)
 	}
 
@@ -0,0 +1,248 @@
+package translate
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/joeblew999/mon-house/pkg/translate/ai"
+)
+
+// CacheEntry is one AI translation, stored under the hash of everything that
+// produced it (provider, model, prompt version, glossary, languages and source)
+type CacheEntry struct {
+	Key            string    `json:"key"`
+	Provider       string    `json:"provider"`
+	Model          string    `json:"model"`
+	PromptVersion  int       `json:"prompt_version"`
+	GlossaryHash   string    `json:"glossary_hash"`
+	SourceLanguage string    `json:"source_language"`
+	TargetLanguage string    `json:"target_language"`
+	Context        string    `json:"context,omitempty"`
+	SourceText     string    `json:"source_text"`
+	TargetText     string    `json:"target_text"`
+	Created        time.Time `json:"created"`
+	LastUsed       time.Time `json:"last_used"`
+}
+
+// CacheScope is the part of a cache key shared by every item of one run
+type CacheScope struct {
+	Provider       string
+	Model          string
+	GlossaryHash   string
+	SourceLanguage string
+	TargetLanguage string
+}
+
+// AICache is a content-addressed cache of AI responses, so re-running
+// translate auto never pays twice for the same text
+// Stored in: {rootDir}/{eventsPath}/ai-cache.json, next to the event log
+type AICache struct {
+	filePath string
+	entries  map[string]CacheEntry
+}
+
+// LoadAICache loads the AI response cache (an empty cache if none exists yet)
+// Single entry point for AI cache loading
+func LoadAICache(rootDir string, eventsPath string) (*AICache, error) {
+	cache := &AICache{
+		filePath: filepath.Join(rootDir, eventsPath, "ai-cache.json"),
+		entries:  make(map[string]CacheEntry),
+	}
+
+	data, err := os.ReadFile(cache.filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return cache, nil // No cache yet
+		}
+		return nil, fmt.Errorf("failed to read AI cache: %w", err)
+	}
+
+	var stored struct {
+		Entries []CacheEntry `json:"entries"`
+	}
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, fmt.Errorf("failed to parse AI cache: %w", err)
+	}
+
+	for _, entry := range stored.Entries {
+		cache.entries[entry.Key] = entry
+	}
+
+	return cache, nil
+}
+
+// Save writes the cache back to disk
+func (c *AICache) Save() error {
+	entries := make([]CacheEntry, 0, len(c.entries))
+	for _, entry := range c.entries {
+		entries = append(entries, entry)
+	}
+
+	// Stable order keeps the file diffable
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Key < entries[j].Key })
+
+	jsonData, err := json.MarshalIndent(struct {
+		Entries []CacheEntry `json:"entries"`
+	}{entries}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal AI cache: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.filePath), 0755); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	if err := writeFileAtomic(c.filePath, jsonData); err != nil {
+		return fmt.Errorf("failed to write AI cache: %w", err)
+	}
+
+	return nil
+}
+
+// Path returns the cache file path
+func (c *AICache) Path() string {
+	return c.filePath
+}
+
+// Lookup returns the cached translation of an item and marks it used
+func (c *AICache) Lookup(scope CacheScope, item ai.TranslationItem) (string, bool) {
+	key := cacheKey(scope, item)
+	entry, ok := c.entries[key]
+	if !ok {
+		return "", false
+	}
+	entry.LastUsed = time.Now()
+	c.entries[key] = entry
+	return entry.TargetText, true
+}
+
+// Add stores a translation returned by the provider
+func (c *AICache) Add(scope CacheScope, item ai.TranslationItem, targetText string) {
+	if item.SourceText == "" || targetText == "" {
+		return
+	}
+	now := time.Now()
+	key := cacheKey(scope, item)
+	c.entries[key] = CacheEntry{
+		Key:            key,
+		Provider:       scope.Provider,
+		Model:          scope.Model,
+		PromptVersion:  ai.PromptVersion,
+		GlossaryHash:   scope.GlossaryHash,
+		SourceLanguage: scope.SourceLanguage,
+		TargetLanguage: scope.TargetLanguage,
+		Context:        item.Context,
+		SourceText:     item.SourceText,
+		TargetText:     targetText,
+		Created:        now,
+		LastUsed:       now,
+	}
+}
+
+// CacheStats summarizes the AI cache
+type CacheStats struct {
+	Entries    int
+	Stale      int            // Entries of an older prompt version (never hit again)
+	ByModel    map[string]int // "provider/model" → entries
+	ByLanguage map[string]int // Target language → entries
+	SizeBytes  int64
+	Oldest     time.Time // Least recently used
+	Newest     time.Time // Most recently used
+}
+
+// Stats returns the cache statistics
+func (c *AICache) Stats() CacheStats {
+	stats := CacheStats{
+		Entries:    len(c.entries),
+		ByModel:    make(map[string]int),
+		ByLanguage: make(map[string]int),
+	}
+	if info, err := os.Stat(c.filePath); err == nil {
+		stats.SizeBytes = info.Size()
+	}
+	for _, entry := range c.entries {
+		if entry.PromptVersion != ai.PromptVersion {
+			stats.Stale++
+		}
+		stats.ByModel[entry.Provider+"/"+entry.Model]++
+		stats.ByLanguage[entry.TargetLanguage]++
+		if stats.Oldest.IsZero() || entry.LastUsed.Before(stats.Oldest) {
+			stats.Oldest = entry.LastUsed
+		}
+		if entry.LastUsed.After(stats.Newest) {
+			stats.Newest = entry.LastUsed
+		}
+	}
+	return stats
+}
+
+// Prune removes stale entries (older prompt versions) and entries not used
+// within maxAge (maxAge <= 0 keeps them); returns the number removed
+func (c *AICache) Prune(maxAge time.Duration) int {
+	cutoff := time.Now().Add(-maxAge)
+	removed := 0
+	for key, entry := range c.entries {
+		if entry.PromptVersion != ai.PromptVersion || (maxAge > 0 && entry.LastUsed.Before(cutoff)) {
+			delete(c.entries, key)
+			removed++
+		}
+	}
+	return removed
+}
+
+// Clear removes every entry; returns the number removed
+func (c *AICache) Clear() int {
+	removed := len(c.entries)
+	c.entries = make(map[string]CacheEntry)
+	return removed
+}
+
+// GlossaryHash identifies the terminology sent with a request (empty terminology has its own hash)
+func GlossaryHash(terminology map[string]string) string {
+	terms := make([]string, 0, len(terminology))
+	for source, target := range terminology {
+		terms = append(terms, source+"\x1f"+target)
+	}
+	sort.Strings(terms)
+	sum := sha256.Sum256([]byte(strings.Join(terms, "\x1e")))
+	return hex.EncodeToString(sum[:8])
+}
+
+func cacheKey(scope CacheScope, item ai.TranslationItem) string {
+	parts := []string{
+		scope.Provider, scope.Model, fmt.Sprint(ai.PromptVersion), scope.GlossaryHash,
+		scope.SourceLanguage, scope.TargetLanguage, item.Context, item.SourceText,
+	}
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x1f")))
+	return hex.EncodeToString(sum[:])
+}
+
+// writeFileAtomic writes data to a temporary file in the same directory and renames it over path,
+// so readers see either the old file or the new one, never a partial write
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+"-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // No-op once renamed
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(0644); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -2,6 +2,7 @@ package commands
 
 import (
 	"fmt"
+	"path/filepath"
 	"time"
 
 	"github.com/joeblew999/mon-house/pkg/translate"
@@ -12,12 +13,15 @@ import (
 // This is the COMMAND HANDLER (CQRS pattern) with Event Sourcing
 type ApplyHandler struct {
 	eventStore *events.Store
+	memory     *translate.Memory
 }
 
-// NewApplyHandler creates a new ApplyHandler with event store
-func NewApplyHandler(eventStore *events.Store) *ApplyHandler {
+// NewApplyHandler creates a new ApplyHandler with event store and translation memory
+// memory may be nil (applied translations are then not remembered)
+func NewApplyHandler(eventStore *events.Store, memory *translate.Memory) *ApplyHandler {
 	return &ApplyHandler{
 		eventStore: eventStore,
+		memory:     memory,
 	}
 }
 
@@ -29,7 +33,12 @@ func (h *ApplyHandler) Handle(cmd *ApplyCommand) (*ApplyResult, error) {
 		return nil, fmt.Errorf("invalid apply command: %w", err)
 	}
 
-	// Step 2: Load task file (QUERY - no side effects)
+	// Step 2: Load configuration and task file (QUERY - no side effects)
+	config, err := translate.LoadConfig(cmd.RootDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+
 	task, err := translate.LoadTask(cmd.RootDir, cmd.TaskFile)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load task: %w", err)
@@ -52,38 +61,115 @@ func (h *ApplyHandler) Handle(cmd *ApplyCommand) (*ApplyResult, error) {
 	}
 
 	// Step 3: Validate task has translations (QUERY - no side effects)
+	// With OnlyApproved, translations not approved yet are held back as if empty
+	heldBack := 0
+	if cmd.OnlyApproved {
+		filled := translate.ValidateTask(task).FilledExtractions
+		task = translate.ApprovedOnly(task)
+		heldBack = filled - translate.ValidateTask(task).FilledExtractions
+	}
 	stats := translate.ValidateTask(task)
 	if stats.FilledExtractions == 0 {
+		if cmd.OnlyApproved {
+			return nil, fmt.Errorf("no approved translations in task file (approve with: mon-tool translate review %s approve)", cmd.TaskFile)
+		}
 		return nil, fmt.Errorf("no translations found in task file (all target_text fields are empty)")
 	}
 
+	// Step 4: Run QA on the translations (QUERY - no side effects)
+	// The glossary tells which Latin words may stay in the translation
+	var glossary *translate.Glossary
+	if target, ok := config.Target(task.TargetLanguage); ok {
+		if glossary, err = translate.LoadGlossary(cmd.RootDir, config, target); err != nil {
+			return nil, fmt.Errorf("failed to load glossary: %w", err)
+		}
+	}
+	report := translate.CheckTask(task, glossary, config.DoNotTranslate)
+	if h.eventStore != nil {
+		h.eventStore.Append(&events.TaskChecked{
+			BaseEvent: events.BaseEvent{
+				Type:      "TaskChecked",
+				Occurred:  time.Now(),
+				SessionID: h.eventStore.SessionID(),
+			},
+			TaskFile:     cmd.TaskFile,
+			CheckedCount: report.Checked,
+			ErrorCount:   report.Count(translate.QAError),
+			WarningCount: report.Count(translate.QAWarning),
+		})
+	}
+	if report.HasErrors() && !cmd.Force {
+		return nil, fmt.Errorf("QA found %d errors (see: mon-tool translate qa %s, or apply with --force)",
+			report.Count(translate.QAError), cmd.TaskFile)
+	}
+
 	result := &ApplyResult{
 		TotalExtractions:  stats.TotalExtractions,
 		FilledExtractions: stats.FilledExtractions,
+		QAErrors:          report.Count(translate.QAError),
+		QAWarnings:        report.Count(translate.QAWarning),
+		HeldBack:          heldBack,
 	}
 
-	// Step 4: Apply translations if not dry-run (COMMAND - changes state)
+	// Step 5: Apply translations if not dry-run (COMMAND - changes state)
 	if !cmd.DryRun {
-		applyStats, err := translate.ApplyTranslations(cmd.RootDir, task)
+		applyStats, err := translate.ApplyTranslations(cmd.RootDir, task, config)
 		if err != nil {
 			return nil, fmt.Errorf("failed to apply translations: %w", err)
 		}
 
 		result.FilesProcessed = applyStats.FilesProcessed
 		result.FilesSkipped = applyStats.FilesSkipped
+		result.AppliedExtractions = applyStats.AppliedExtractions
+		result.Unresolved = applyStats.Unresolved
+
+		// Apply to the base snapshots too, so later hand edits still show against them
+		if err := translate.ApplyToBases(cmd.RootDir, task, config); err != nil {
+			return nil, fmt.Errorf("failed to update base snapshots: %w", err)
+		}
+
+		// Feed applied translations back into the translation memory
+		if h.memory != nil {
+			result.MemoryEntriesAdded = rememberApplied(h.memory, task, applyStats.Unresolved)
+			if err := h.memory.Save(); err != nil {
+				return nil, fmt.Errorf("failed to save translation memory: %w", err)
+			}
+		}
 
 		// Emit TranslationApplied events for each file
 		if h.eventStore != nil {
+			unresolvedByFile := make(map[string]int)
+			for _, u := range applyStats.Unresolved {
+				unresolvedByFile[u.File]++
+			}
+
+			unresolvedIDs := make(map[string]bool)
+			for _, u := range applyStats.Unresolved {
+				unresolvedIDs[u.File+"\x00"+u.ID] = true
+			}
+
 			for _, file := range task.Files {
 				appliedCount := 0
 				skippedCount := 0
+				var ids []string
 				for _, ext := range file.Extractions {
 					if ext.TargetText != "" {
 						appliedCount++
+						if !unresolvedIDs[file.Target+"\x00"+ext.ID] {
+							ids = append(ids, ext.ID)
+						}
 					} else {
 						skippedCount++
 					}
 				}
+				appliedCount -= unresolvedByFile[file.Target]
+				skippedCount += unresolvedByFile[file.Target]
+
+				// Tasks written before source hashes were recorded: hash the source now
+				sourceHash := file.SourceHash
+				if sourceHash == "" {
+					sourceHash, _ = translate.FileHash(filepath.Join(cmd.RootDir, file.Source))
+				}
 
 				h.eventStore.Append(&events.TranslationApplied{
 					BaseEvent: events.BaseEvent{
@@ -91,16 +177,19 @@ func (h *ApplyHandler) Handle(cmd *ApplyCommand) (*ApplyResult, error) {
 						Occurred:  time.Now(),
 						SessionID: h.eventStore.SessionID(),
 					},
-					FilePath:     file.Target,
-					FileType:     file.Type,
-					AppliedCount: appliedCount,
-					SkippedCount: skippedCount,
+					FilePath:      file.Target,
+					FileType:      file.Type,
+					SourcePath:    file.Source,
+					SourceHash:    sourceHash,
+					AppliedCount:  appliedCount,
+					SkippedCount:  skippedCount,
+					ExtractionIDs: ids,
 				})
 			}
 		}
 
-		// Step 5: Delete task file if all successful (COMMAND - changes state)
-		if applyStats.FilesProcessed > 0 && applyStats.FilesSkipped == 0 && applyStats.FilledExtractions == applyStats.TotalExtractions {
+		// Step 6: Delete task file if all successful (COMMAND - changes state)
+		if applyStats.FilesProcessed > 0 && applyStats.FilesSkipped == 0 && len(applyStats.Unresolved) == 0 && applyStats.FilledExtractions == applyStats.TotalExtractions {
 			if err := translate.DeleteTask(cmd.RootDir, cmd.TaskFile); err != nil {
 				// Don't fail the whole operation if we can't delete the task file
 				// Just mark it as not deleted
@@ -127,6 +216,27 @@ func (h *ApplyHandler) Handle(cmd *ApplyCommand) (*ApplyResult, error) {
 	return result, nil
 }
 
+// rememberApplied adds every applied translation to the memory
+// Unresolved extractions were not written, so they are not remembered
+func rememberApplied(memory *translate.Memory, task *translate.Task, unresolved []translate.UnresolvedExtraction) int {
+	skip := make(map[string]bool)
+	for _, u := range unresolved {
+		skip[u.File+"\x00"+u.ID] = true
+	}
+
+	added := 0
+	for _, file := range task.Files {
+		for _, ext := range file.Extractions {
+			if ext.TargetText == "" || skip[file.Target+"\x00"+ext.ID] {
+				continue
+			}
+			memory.Add(task.SourceLanguage, task.TargetLanguage, ext)
+			added++
+		}
+	}
+	return added
+}
+
 // countExtractions counts total extractions in a task
 func countExtractions(task *translate.Task) int {
 	count := 0
@@ -14,12 +14,15 @@ import (
 // This is the COMMAND HANDLER (CQRS pattern) with Event Sourcing
 type SyncHandler struct {
 	eventStore *events.Store
+	memory     *translate.Memory
 }
 
-// NewSyncHandler creates a new SyncHandler with event store
-func NewSyncHandler(eventStore *events.Store) *SyncHandler {
+// NewSyncHandler creates a new SyncHandler with event store and translation memory
+// memory may be nil (tasks are then generated without pre-filled translations)
+func NewSyncHandler(eventStore *events.Store, memory *translate.Memory) *SyncHandler {
 	return &SyncHandler{
 		eventStore: eventStore,
+		memory:     memory,
 	}
 }
 
@@ -41,33 +44,52 @@ func (h *SyncHandler) Handle(cmd *SyncCommand) (*SyncResult, error) {
 		return nil, fmt.Errorf("failed to load config: %w", err)
 	}
 
-	// Step 3: Find source directory
-	sourceDir := filepath.Join(cmd.RootDir, config.Source.Folder)
+	// Step 3: Find target config
+	target, ok := config.Target(cmd.TargetLang)
+	if !ok {
+		return nil, fmt.Errorf("target language %s not found in config", cmd.TargetLang)
+	}
+	targetConfig := &target
 
-	// Step 4: Find target config
-	var targetConfig *translate.TargetConfig
-	for _, target := range config.Targets {
-		if target.Language == cmd.TargetLang {
-			targetConfig = &target
-			break
+	// Step 4: Scan every source folder and plan sync actions (QUERY - no side effects)
+	var actions []translate.SyncAction
+	for _, folder := range config.FolderMappings(*targetConfig) {
+		folderActions, err := translate.ScanSource(cmd.RootDir, folder, *targetConfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan source %s: %w", folder.Source, err)
 		}
-	}
-	if targetConfig == nil {
-		return nil, fmt.Errorf("target language %s not found in config", cmd.TargetLang)
+		actions = append(actions, folderActions...)
 	}
 
-	// Step 5: Scan source and plan sync actions (QUERY - no side effects)
-	actions, err := translate.ScanSource(cmd.RootDir, sourceDir, *targetConfig)
+	// JSON documents (e.g. code/drawings.json) get a per-language variant
+	jsonActions, err := translate.ScanJSONDocuments(cmd.RootDir, config.JSONDocuments, *targetConfig)
 	if err != nil {
-		return nil, fmt.Errorf("failed to scan source: %w", err)
+		return nil, fmt.Errorf("failed to scan JSON documents: %w", err)
 	}
+	actions = append(actions, jsonActions...)
 
-	// Step 6: Get statistics (QUERY - no side effects)
+	// Step 5: Get statistics (QUERY - no side effects)
 	mkdirs, copies, deletes := translate.GetSyncStats(actions)
 	result.DirectoriesCreated = mkdirs
 	result.FilesCopied = copies
 	result.FilesDeleted = deletes
 
+	// Step 6: Read the translations of unchanged text before the copies overwrite them (QUERY - no side effects)
+	carried, err := translate.PlanCarry(cmd.RootDir, config, *targetConfig, actions, h.memory)
+	if err != nil {
+		return nil, fmt.Errorf("failed to plan carrying translations: %w", err)
+	}
+	for _, file := range carried {
+		result.TranslationsCarried += len(file.Extractions)
+	}
+
+	// Also keep the targets edited by hand since sync or apply last wrote them
+	edited, err := translate.PlanMerge(cmd.RootDir, config, actions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find edited targets: %w", err)
+	}
+	result.TargetsMerged = len(edited)
+
 	// Step 7: Execute sync if not dry-run (COMMAND - changes state)
 	if !cmd.DryRun {
 		if err := translate.ExecuteSync(actions); err != nil {
@@ -92,6 +114,7 @@ func (h *SyncHandler) Handle(cmd *SyncCommand) (*SyncResult, error) {
 					if info, err := os.Stat(action.Source); err == nil {
 						size = info.Size()
 					}
+					hash, _ := translate.FileHash(action.Source)
 					h.eventStore.Append(&events.FileCopied{
 						BaseEvent: events.BaseEvent{
 							Type:      "FileCopied",
@@ -100,6 +123,7 @@ func (h *SyncHandler) Handle(cmd *SyncCommand) (*SyncResult, error) {
 						},
 						SourcePath: action.Source,
 						TargetPath: action.Target,
+						SourceHash: hash,
 						Size:       size,
 						FileType:   action.Type,
 					})
@@ -118,15 +142,115 @@ func (h *SyncHandler) Handle(cmd *SyncCommand) (*SyncResult, error) {
 		}
 	}
 
-	// Step 8: Get translatable files (QUERY - no side effects)
-	filesToTranslate := translate.GetTranslatableFiles(actions)
+	// Step 8: Write the carried translations into the fresh copies (COMMAND - changes state)
+	// Units that could not be written drop out of carried, so steps 10-11 translate them
+	if !cmd.DryRun && len(carried) > 0 {
+		var carryStats *translate.ApplyStats
+		carried, carryStats, err = translate.CarryTranslations(cmd.RootDir, config, *targetConfig, carried)
+		if err != nil {
+			return nil, fmt.Errorf("failed to carry translations: %w", err)
+		}
+		result.TranslationsCarried = carryStats.AppliedExtractions
+
+		// Emit TranslationsCarried events for each file
+		if h.eventStore != nil {
+			for _, file := range carried {
+				ids := make([]string, 0, len(file.Extractions))
+				for _, ext := range file.Extractions {
+					ids = append(ids, ext.ID)
+				}
+				h.eventStore.Append(&events.TranslationsCarried{
+					BaseEvent: events.BaseEvent{
+						Type:      "TranslationsCarried",
+						Occurred:  time.Now(),
+						SessionID: h.eventStore.SessionID(),
+					},
+					FilePath:      file.Target,
+					FileType:      file.Type,
+					SourcePath:    file.Source,
+					SourceHash:    file.SourceHash,
+					CarriedCount:  len(file.Extractions),
+					ChangedCount:  file.Changed,
+					ExtractionIDs: ids,
+				})
+			}
+		}
+	}
+
+	// Step 9: Merge hand edits back in and snapshot what sync wrote as the new bases (COMMAND - changes state)
+	if !cmd.DryRun {
+		merges, err := translate.MergeTargets(cmd.RootDir, config, edited)
+		if err != nil {
+			return nil, fmt.Errorf("failed to merge edited targets: %w", err)
+		}
+		for _, merge := range merges {
+			result.MergeConflicts += len(merge.Conflicts)
+		}
+		result.ConflictReport, err = translate.WriteConflictReport(cmd.RootDir, config, cmd.TargetLang, merges)
+		if err != nil {
+			return nil, fmt.Errorf("failed to write conflict report: %w", err)
+		}
 
-	// Step 9: Generate task file if not dry-run (COMMAND - changes state)
+		merged := make(map[string]bool)
+		for _, merge := range merges {
+			merged[merge.Target] = true
+		}
+		for _, action := range actions {
+			target, _ := filepath.Rel(cmd.RootDir, action.Target)
+			switch {
+			case action.Action == "copy" && action.Type != "other" && !merged[target]:
+				err = translate.SaveBase(cmd.RootDir, config, target)
+			case action.Action == "delete":
+				err = translate.RemoveBase(cmd.RootDir, config, target)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to snapshot %s: %w", target, err)
+			}
+		}
+
+		// Emit TargetMerged events for each file
+		if h.eventStore != nil {
+			for _, merge := range merges {
+				mergedEvent := &events.TargetMerged{
+					BaseEvent: events.BaseEvent{
+						Type:      "TargetMerged",
+						Occurred:  time.Now(),
+						SessionID: h.eventStore.SessionID(),
+					},
+					FilePath:      merge.Target,
+					FileType:      merge.Type,
+					ConflictCount: len(merge.Conflicts),
+				}
+				if len(merge.Conflicts) > 0 {
+					mergedEvent.ConflictReport = result.ConflictReport
+				}
+				h.eventStore.Append(mergedEvent)
+			}
+		}
+	}
+
+	// Step 10: Get translatable files, leaving out those carried across completely (QUERY - no side effects)
+	fullyCarried := make(map[string]bool)
+	for _, file := range carried {
+		if file.Changed == 0 {
+			fullyCarried[filepath.Join(cmd.RootDir, file.Target)] = true
+		}
+	}
+	var filesToTranslate []string
+	for _, file := range translate.GetTranslatableFiles(actions) {
+		if !fullyCarried[file] {
+			filesToTranslate = append(filesToTranslate, file)
+		}
+	}
+
+	// Step 11: Generate task file if not dry-run (COMMAND - changes state)
 	if !cmd.DryRun && len(filesToTranslate) > 0 {
-		extractionCount, err := translate.GenerateTask(cmd.RootDir, *targetConfig, filesToTranslate, config.Paths.Tasks)
+		taskStats, err := translate.GenerateTask(cmd.RootDir, config, *targetConfig, filesToTranslate, h.memory, carried)
 		if err != nil {
 			return nil, fmt.Errorf("failed to generate task: %w", err)
 		}
+		result.MemoryExactMatches = taskStats.ExactMatches
+		result.MemoryFuzzyMatches = taskStats.FuzzyMatches
 
 		taskFile := fmt.Sprintf("%s/translate-%s.json", config.Paths.Tasks, cmd.TargetLang)
 		result.TasksGenerated = append(result.TasksGenerated, taskFile)
@@ -142,9 +266,61 @@ func (h *SyncHandler) Handle(cmd *SyncCommand) (*SyncResult, error) {
 				TaskFile:        taskFile,
 				TargetLanguage:  cmd.TargetLang,
 				FileCount:       len(filesToTranslate),
-				ExtractionCount: extractionCount,
+				ExtractionCount: taskStats.TotalExtractions,
+				PrefilledCount:  taskStats.ExactMatches,
+				FuzzyCount:      taskStats.FuzzyMatches,
 			})
 		}
+	} else if !cmd.DryRun && len(carried) > 0 {
+		// Everything was carried across: a task left from an earlier sync is out of date
+		taskFile := fmt.Sprintf("%s/translate-%s.json", config.Paths.Tasks, cmd.TargetLang)
+		if _, err := os.Stat(filepath.Join(cmd.RootDir, taskFile)); err == nil {
+			if err := translate.DeleteTask(cmd.RootDir, taskFile); err != nil {
+				return nil, err
+			}
+			if h.eventStore != nil {
+				h.eventStore.Append(&events.TaskDeleted{
+					BaseEvent: events.BaseEvent{
+						Type:      "TaskDeleted",
+						Occurred:  time.Now(),
+						SessionID: h.eventStore.SessionID(),
+					},
+					TaskFile: taskFile,
+					Reason:   "translations_carried",
+				})
+			}
+		}
+	}
+
+	// Step 12: Seed the glossary with drawing-standards terms (COMMAND - changes state)
+	if !cmd.DryRun {
+		glossary, err := translate.LoadGlossary(cmd.RootDir, config, *targetConfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load glossary: %w", err)
+		}
+		added, err := translate.SeedGlossary(glossary, filepath.Join(cmd.RootDir, config.Paths.Standards))
+		if err != nil {
+			return nil, fmt.Errorf("failed to seed glossary: %w", err)
+		}
+		if added > 0 {
+			if err := glossary.Save(); err != nil {
+				return nil, err
+			}
+			result.GlossaryTermsSeeded = added
+
+			if h.eventStore != nil {
+				h.eventStore.Append(&events.GlossarySeeded{
+					BaseEvent: events.BaseEvent{
+						Type:      "GlossarySeeded",
+						Occurred:  time.Now(),
+						SessionID: h.eventStore.SessionID(),
+					},
+					GlossaryFile:   targetConfig.Glossary,
+					TargetLanguage: cmd.TargetLang,
+					TermsAdded:     added,
+				})
+			}
+		}
 	}
 
 	return result, nil
@@ -1,5 +1,7 @@
 package commands
 
+import "github.com/joeblew999/mon-house/pkg/translate"
+
 // Command is the interface that all commands must implement
 // Commands are operations that CHANGE state (CQRS pattern)
 type Command interface {
@@ -36,6 +38,9 @@ type ApplyCommand struct {
 	RootDir  string // Working directory
 	TaskFile string // Path to task JSON file (e.g., "tasks/translate-th.json")
 	DryRun   bool   // If true, preview only without making changes
+	Force    bool   // If true, apply even when QA finds errors
+	// If true, apply only approved translations (the rest stay in the task file)
+	OnlyApproved bool
 }
 
 // Validate checks if the ApplyCommand is valid
@@ -59,17 +64,30 @@ type Result struct {
 
 // SyncResult contains the outcome of a SyncCommand
 type SyncResult struct {
-	DirectoriesCreated int
-	FilesCopied        int
-	FilesDeleted       int
-	TasksGenerated     []string // List of task files generated
+	DirectoriesCreated  int
+	FilesCopied         int
+	FilesDeleted        int
+	TasksGenerated      []string // List of task files generated
+	TranslationsCarried int      // Unchanged translations carried across a resync
+	TargetsMerged       int      // Hand-edited targets merged with their new version
+	MergeConflicts      int      // Regions both the hand edit and the source changed
+	ConflictReport      string   // Report listing the conflicts ("" if none)
+	MemoryExactMatches  int      // Extractions pre-filled from translation memory
+	MemoryFuzzyMatches  int      // Extractions marked with a fuzzy memory suggestion
+	GlossaryTermsSeeded int      // Drawing-standards terms added to the glossary
 }
 
 // ApplyResult contains the outcome of an ApplyCommand
 type ApplyResult struct {
-	FilesProcessed    int
-	FilesSkipped      int
-	TotalExtractions  int
-	FilledExtractions int
-	TaskFileDeleted   bool
+	FilesProcessed     int
+	FilesSkipped       int
+	TotalExtractions   int
+	FilledExtractions  int
+	AppliedExtractions int
+	Unresolved         []translate.UnresolvedExtraction // Filled extractions that could not be located
+	MemoryEntriesAdded int                              // Translations written to translation memory
+	QAErrors           int                              // QA errors found before applying
+	QAWarnings         int                              // QA warnings found before applying
+	HeldBack           int                              // Filled but not approved (OnlyApproved)
+	TaskFileDeleted    bool
 }
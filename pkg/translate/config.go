@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
+
+	"github.com/joeblew999/mon-house/pkg/translate/ai"
 )
 
 // LoadConfig loads and parses the translate.json configuration file
@@ -22,6 +25,18 @@ func LoadConfig(rootDir string) (*Config, error) {
 		return nil, fmt.Errorf("failed to parse translate.json: %w", err)
 	}
 
+	if config.Source.Language == "" {
+		return nil, fmt.Errorf("translate.json: source.language is required")
+	}
+	if config.Source.LanguageName == "" {
+		config.Source.LanguageName = config.Source.Language
+	}
+	for _, folder := range config.Source.Folders {
+		if folder.Source == "" || folder.Target == "" {
+			return nil, fmt.Errorf("translate.json: source.folders entries need both source and target")
+		}
+	}
+
 	// Set default paths if not specified (NO HARDCODED PATHS!)
 	if config.Paths.Tasks == "" {
 		config.Paths.Tasks = "tasks"
@@ -29,6 +44,60 @@ func LoadConfig(rootDir string) (*Config, error) {
 	if config.Paths.Events == "" {
 		config.Paths.Events = ".mon-tool"
 	}
+	if config.Paths.Standards == "" {
+		config.Paths.Standards = "drawing-standards.json"
+	}
+	for i := range config.Targets {
+		if config.Targets[i].Glossary == "" {
+			config.Targets[i].Glossary = filepath.Join("code", "glossary."+config.Targets[i].Language+".json")
+		}
+	}
+
+	// Default translatable SVG content
+	if len(config.SVG.Elements) == 0 {
+		config.SVG.Elements = []string{"text", "title", "desc"}
+	}
+	if config.SVG.Attributes == nil {
+		config.SVG.Attributes = []string{"aria-label"}
+	}
+
+	// Default AI provider and batching
+	if config.AI.Provider == "" {
+		config.AI.Provider = "claude"
+	}
+	if config.AI.BatchTokens <= 0 {
+		config.AI.BatchTokens = ai.DefaultBatchTokens
+	}
+	if config.AI.Workers <= 0 {
+		config.AI.Workers = 4
+	}
+	config.AI.Pricing = ai.DefaultPricing.Merge(config.AI.Pricing)
 
 	return &config, nil
 }
+
+// FolderMappings returns every source → target folder pair for a target language
+// The primary source.folder maps to the target's folder; source.folders adds more
+func (c *Config) FolderMappings(target TargetConfig) []FolderMapping {
+	var mappings []FolderMapping
+	if c.Source.Folder != "" {
+		mappings = append(mappings, FolderMapping{Source: c.Source.Folder, Target: target.Folder})
+	}
+	for _, folder := range c.Source.Folders {
+		mappings = append(mappings, FolderMapping{
+			Source: folder.Source,
+			Target: strings.ReplaceAll(folder.Target, "{language}", target.Language),
+		})
+	}
+	return mappings
+}
+
+// Target returns the target configuration for a language
+func (c *Config) Target(language string) (TargetConfig, bool) {
+	for _, target := range c.Targets {
+		if target.Language == language {
+			return target, true
+		}
+	}
+	return TargetConfig{}, false
+}
@@ -0,0 +1,177 @@
+package translate
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/joeblew999/mon-house/pkg/translate/events"
+)
+
+// SpendRecord is the spending of one translate auto run, from the event log
+type SpendRecord struct {
+	Time         time.Time
+	Model        string
+	Language     string
+	TaskFile     string
+	Items        int
+	CacheHits    int
+	InputTokens  int
+	OutputTokens int
+	CostUSD      float64
+	Failed       bool // Run stopped early (its finished batches were still paid for)
+}
+
+// Month returns the record's month as "2006-01"
+func (r SpendRecord) Month() string {
+	return r.Time.Format("2006-01")
+}
+
+// LoadSpending reads every AI run's cost from the event log
+// (AITranslationCompleted, AITranslationFailed for runs that spent money first,
+// and TranslationVerified back-translations)
+// Single entry point for AI spending queries
+func LoadSpending(rootDir string, eventsPath string) ([]SpendRecord, error) {
+	records, err := events.ReadAll(rootDir, eventsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var spending []SpendRecord
+	for _, record := range records {
+		switch record.Type {
+		case "AITranslationCompleted":
+			var e events.AITranslationCompleted
+			if err := record.Unmarshal(&e); err != nil {
+				continue
+			}
+			spending = append(spending, SpendRecord{
+				Time:         record.Timestamp,
+				Model:        e.Model,
+				Language:     spendLanguage(e.TargetLanguage, e.TaskFile),
+				TaskFile:     e.TaskFile,
+				Items:        e.ItemsTranslated,
+				CacheHits:    e.CacheHits,
+				InputTokens:  e.InputTokens,
+				OutputTokens: e.OutputTokens,
+				CostUSD:      e.CostUSD,
+			})
+		case "AITranslationFailed":
+			var e events.AITranslationFailed
+			if err := record.Unmarshal(&e); err != nil || (e.CostUSD == 0 && e.InputTokens == 0) {
+				continue
+			}
+			spending = append(spending, SpendRecord{
+				Time:         record.Timestamp,
+				Model:        e.Model,
+				Language:     spendLanguage(e.TargetLanguage, e.TaskFile),
+				TaskFile:     e.TaskFile,
+				Items:        e.ItemsTranslated,
+				InputTokens:  e.InputTokens,
+				OutputTokens: e.OutputTokens,
+				CostUSD:      e.CostUSD,
+				Failed:       true,
+			})
+		case "TranslationVerified":
+			var e events.TranslationVerified
+			if err := record.Unmarshal(&e); err != nil || (e.CostUSD == 0 && e.InputTokens == 0) {
+				continue
+			}
+			spending = append(spending, SpendRecord{
+				Time:         record.Timestamp,
+				Model:        e.Model,
+				Language:     spendLanguage(e.TargetLanguage, e.TaskFile),
+				TaskFile:     e.TaskFile,
+				Items:        e.ItemsChecked,
+				InputTokens:  e.InputTokens,
+				OutputTokens: e.OutputTokens,
+				CostUSD:      e.CostUSD,
+				Failed:       e.Error != "",
+			})
+		}
+	}
+	return spending, nil
+}
+
+// MonthSpend sums the spending in the month of t
+func MonthSpend(spending []SpendRecord, t time.Time) float64 {
+	month := t.Format("2006-01")
+	total := 0.0
+	for _, record := range spending {
+		if record.Month() == month {
+			total += record.CostUSD
+		}
+	}
+	return total
+}
+
+// SpendGroup totals the runs sharing a key (model, language or month)
+type SpendGroup struct {
+	Key          string
+	Runs         int
+	Items        int
+	CacheHits    int
+	InputTokens  int
+	OutputTokens int
+	CostUSD      float64
+}
+
+// GroupSpending totals spending by a key, sorted by key
+func GroupSpending(spending []SpendRecord, key func(SpendRecord) string) []SpendGroup {
+	groups := make(map[string]*SpendGroup)
+	for _, record := range spending {
+		k := key(record)
+		group, ok := groups[k]
+		if !ok {
+			group = &SpendGroup{Key: k}
+			groups[k] = group
+		}
+		group.Runs++
+		group.Items += record.Items
+		group.CacheHits += record.CacheHits
+		group.InputTokens += record.InputTokens
+		group.OutputTokens += record.OutputTokens
+		group.CostUSD += record.CostUSD
+	}
+
+	result := make([]SpendGroup, 0, len(groups))
+	for _, group := range groups {
+		result = append(result, *group)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Key < result[j].Key })
+	return result
+}
+
+// BudgetLimit returns how much the next run may spend under the configured caps,
+// whether any cap applies, and an error if a cap is already used up
+func BudgetLimit(budget BudgetConfig, spending []SpendRecord, now time.Time) (float64, bool, error) {
+	limit, capped := budget.PerRunUSD, budget.PerRunUSD > 0
+	if budget.PerMonthUSD > 0 {
+		spent := MonthSpend(spending, now)
+		remaining := budget.PerMonthUSD - spent
+		if remaining <= 0 {
+			return 0, true, fmt.Errorf("monthly budget of $%.2f used up ($%.4f spent in %s)",
+				budget.PerMonthUSD, spent, now.Format("2006-01"))
+		}
+		if !capped || remaining < limit {
+			limit = remaining
+		}
+		capped = true
+	}
+	return limit, capped, nil
+}
+
+// spendLanguage returns the event's target language, or derives it from a
+// task file named translate-{language}.json (events recorded before the field existed)
+func spendLanguage(language string, taskFile string) string {
+	if language != "" {
+		return language
+	}
+	name := strings.TrimSuffix(filepath.Base(taskFile), ".json")
+	if lang, ok := strings.CutPrefix(name, "translate-"); ok {
+		return lang
+	}
+	return "unknown"
+}
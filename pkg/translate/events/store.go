@@ -94,6 +94,10 @@ func (r *EventRecord) Unmarshal(v interface{}) error {
 	return json.Unmarshal(r.Raw, v)
 }
 
+// maxEventSize is the longest event line ReadAll accepts (bufio's default
+// is 64 KB, and one long line would make the whole log unreadable)
+const maxEventSize = 16 << 20
+
 // ReadAll reads all events from the store
 func ReadAll(rootDir string, eventsPath string) ([]EventRecord, error) {
 	filePath := filepath.Join(rootDir, eventsPath, "events.jsonl")
@@ -109,10 +113,12 @@ func ReadAll(rootDir string, eventsPath string) ([]EventRecord, error) {
 
 	var records []EventRecord
 	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, maxEventSize)
 
 	for scanner.Scan() {
 		var record EventRecord
-		line := scanner.Bytes()
+		// Copy: the scanner reuses its buffer, and Raw outlives this iteration
+		line := append([]byte(nil), scanner.Bytes()...)
 
 		// First unmarshal to get type and timestamp
 		var base BaseEvent
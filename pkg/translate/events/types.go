@@ -36,6 +36,7 @@ type FileCopied struct {
 	BaseEvent
 	SourcePath string `json:"source_path"`
 	TargetPath string `json:"target_path"`
+	SourceHash string `json:"source_hash,omitempty"` // Content hash of the source when copied
 	Size       int64  `json:"size_bytes"`
 	FileType   string `json:"file_type"` // "svg", "md", "other"
 }
@@ -50,10 +51,12 @@ type FileDeleted struct {
 // TaskGenerated fires when a translation task file is created
 type TaskGenerated struct {
 	BaseEvent
-	TaskFile       string `json:"task_file"`
-	TargetLanguage string `json:"target_language"`
-	FileCount      int    `json:"file_count"`
-	ExtractionCount int   `json:"extraction_count"`
+	TaskFile        string `json:"task_file"`
+	TargetLanguage  string `json:"target_language"`
+	FileCount       int    `json:"file_count"`
+	ExtractionCount int    `json:"extraction_count"`
+	PrefilledCount  int    `json:"prefilled_count,omitempty"` // Exact translation memory matches
+	FuzzyCount      int    `json:"fuzzy_count,omitempty"`     // Fuzzy translation memory matches
 }
 
 // Apply Events (from ApplyCommand)
@@ -71,10 +74,36 @@ type TaskLoaded struct {
 // TranslationApplied fires when translations are applied to a file
 type TranslationApplied struct {
 	BaseEvent
-	FilePath        string `json:"file_path"`
-	FileType        string `json:"file_type"`
-	AppliedCount    int    `json:"applied_count"`
-	SkippedCount    int    `json:"skipped_count"`
+	FilePath     string `json:"file_path"`
+	FileType     string `json:"file_type"`
+	SourcePath   string `json:"source_path,omitempty"`
+	SourceHash   string `json:"source_hash,omitempty"` // Content hash of the source the task was generated from
+	AppliedCount int    `json:"applied_count"`
+	SkippedCount int    `json:"skipped_count"`
+	// Extraction IDs written to the file (sync carries them across a resync)
+	ExtractionIDs []string `json:"extraction_ids,omitempty"`
+}
+
+// TranslationsCarried fires when sync writes the translations of unchanged text
+// back into a fresh copy of a changed source (structure-preserving resync)
+type TranslationsCarried struct {
+	BaseEvent
+	FilePath      string   `json:"file_path"`
+	FileType      string   `json:"file_type"`
+	SourcePath    string   `json:"source_path"`
+	SourceHash    string   `json:"source_hash"`
+	CarriedCount  int      `json:"carried_count"`
+	ChangedCount  int      `json:"changed_count"` // Units left for the task
+	ExtractionIDs []string `json:"extraction_ids,omitempty"`
+}
+
+// TargetMerged fires when sync merges hand edits of a target back into its new version
+type TargetMerged struct {
+	BaseEvent
+	FilePath       string `json:"file_path"`
+	FileType       string `json:"file_type"`
+	ConflictCount  int    `json:"conflict_count"`
+	ConflictReport string `json:"conflict_report,omitempty"`
 }
 
 // TranslationFailed fires when applying translations fails
@@ -92,14 +121,75 @@ type TaskDeleted struct {
 	Reason   string `json:"reason"` // "completed", "manual", etc.
 }
 
+// TaskChecked fires when the QA linter has checked a task
+type TaskChecked struct {
+	BaseEvent
+	TaskFile     string `json:"task_file"`
+	CheckedCount int    `json:"checked_count"`
+	ErrorCount   int    `json:"error_count"`
+	WarningCount int    `json:"warning_count"`
+}
+
+// TextFitChecked fires when translated drawings have been checked for labels that no longer fit
+type TextFitChecked struct {
+	BaseEvent
+	TargetLanguage string `json:"target_language"`
+	DrawingCount   int    `json:"drawing_count"`
+	LabelCount     int    `json:"label_count"`
+	IssueCount     int    `json:"issue_count"`
+	FixedCount     int    `json:"fixed_count"`
+}
+
+// Exchange Events (CAT tool export/import)
+
+// TaskExported fires when a task is exported to an exchange file (XLIFF, ...)
+type TaskExported struct {
+	BaseEvent
+	TaskFile   string `json:"task_file"`
+	OutputFile string `json:"output_file"`
+	Format     string `json:"format"`
+	UnitCount  int    `json:"unit_count"`
+}
+
+// TranslationsImported fires when an exchange file is merged back into a task
+type TranslationsImported struct {
+	BaseEvent
+	InputFile      string `json:"input_file"`
+	TaskFile       string `json:"task_file"`
+	Format         string `json:"format"`
+	UpdatedCount   int    `json:"updated_count"`
+	UntouchedCount int    `json:"untouched_count"`
+	StaleCount     int    `json:"stale_count"`
+	UnknownCount   int    `json:"unknown_count"`
+}
+
+// Glossary Events
+
+// GlossarySeeded fires when drawing-standards terms are added to a glossary
+type GlossarySeeded struct {
+	BaseEvent
+	GlossaryFile   string `json:"glossary_file"`
+	TargetLanguage string `json:"target_language"`
+	TermsAdded     int    `json:"terms_added"`
+}
+
+// GlossaryTermAdded fires when a term translation is added or changed by hand
+type GlossaryTermAdded struct {
+	BaseEvent
+	GlossaryFile   string `json:"glossary_file"`
+	TargetLanguage string `json:"target_language"`
+	Term           string `json:"term"`
+	Translation    string `json:"translation"`
+}
+
 // Query Events (from read operations - optional, can skip for performance)
 
 // ConfigLoaded fires when configuration is loaded
 type ConfigLoaded struct {
 	BaseEvent
-	ConfigPath   string `json:"config_path"`
-	SourceLang   string `json:"source_language"`
-	TargetCount  int    `json:"target_count"`
+	ConfigPath  string `json:"config_path"`
+	SourceLang  string `json:"source_language"`
+	TargetCount int    `json:"target_count"`
 }
 
 // TextExtracted fires when text is extracted from a file
@@ -123,19 +213,130 @@ type AITranslationStarted struct {
 // AITranslationCompleted fires when AI translation succeeds
 type AITranslationCompleted struct {
 	BaseEvent
-	TaskFile         string  `json:"task_file"`
-	ItemsTranslated  int     `json:"items_translated"`
-	InputTokens      int     `json:"input_tokens"`
-	OutputTokens     int     `json:"output_tokens"`
-	CostUSD          float64 `json:"cost_usd"`
-	DurationSeconds  float64 `json:"duration_seconds"`
-	Model            string  `json:"model"`
+	TaskFile        string  `json:"task_file"`
+	TargetLanguage  string  `json:"target_language,omitempty"`
+	ItemsTranslated int     `json:"items_translated"`
+	ItemsFailed     int     `json:"items_failed"` // Sent but without a valid translation after retries
+	CacheHits       int     `json:"cache_hits"`   // Items filled from the AI cache (not sent, not billed)
+	InputTokens     int     `json:"input_tokens"`
+	OutputTokens    int     `json:"output_tokens"`
+	CostUSD         float64 `json:"cost_usd"`
+	DurationSeconds float64 `json:"duration_seconds"`
+	Model           string  `json:"model"`
+}
+
+// AIBatchTranslated fires after each batch of an AI translation run is merged
+// into the task file
+type AIBatchTranslated struct {
+	BaseEvent
+	TaskFile        string  `json:"task_file"`
+	Batch           int     `json:"batch"` // 1-based
+	BatchCount      int     `json:"batch_count"`
+	ItemsSent       int     `json:"items_sent"`
+	ItemsTranslated int     `json:"items_translated"`
+	ItemsFailed     int     `json:"items_failed"`
+	Retries         int     `json:"retries"` // Requests re-sending missing or invalid items
+	InputTokens     int     `json:"input_tokens"`
+	OutputTokens    int     `json:"output_tokens"`
+	CostUSD         float64 `json:"cost_usd"`
+}
+
+// TranslationVerified fires when translate verify back-translated a task
+// (Error is set if it stopped early; the cost is still what was spent)
+type TranslationVerified struct {
+	BaseEvent
+	TaskFile       string  `json:"task_file"`
+	TargetLanguage string  `json:"target_language"`
+	Model          string  `json:"model"`
+	ItemsChecked   int     `json:"items_checked"`
+	ItemsFlagged   int     `json:"items_flagged"`
+	AverageDrift   float64 `json:"average_drift"` // 0-1
+	InputTokens    int     `json:"input_tokens"`
+	OutputTokens   int     `json:"output_tokens"`
+	CostUSD        float64 `json:"cost_usd"`
+	Error          string  `json:"error,omitempty"`
+}
+
+// AICachePruned fires when old entries are removed from the AI response cache
+type AICachePruned struct {
+	BaseEvent
+	RemovedCount   int `json:"removed_count"`
+	RemainingCount int `json:"remaining_count"`
 }
 
 // AITranslationFailed fires when AI translation fails
 type AITranslationFailed struct {
 	BaseEvent
-	TaskFile string `json:"task_file"`
-	Error    string `json:"error"`
-	Model    string `json:"model"`
+	TaskFile       string `json:"task_file"`
+	TargetLanguage string `json:"target_language,omitempty"`
+	Error          string `json:"error"`
+	Reason         string `json:"reason,omitempty"` // "budget", "interrupted", "timeout", "rate_limited", "api_error" or "error"
+	Model          string `json:"model"`
+	// Spent on the batches finished before the failure
+	ItemsTranslated int     `json:"items_translated,omitempty"`
+	InputTokens     int     `json:"input_tokens,omitempty"`
+	OutputTokens    int     `json:"output_tokens,omitempty"`
+	CostUSD         float64 `json:"cost_usd,omitempty"`
+}
+
+// Pipeline Events (from translate full)
+
+// PipelineStarted fires when translate full starts (or resumes) a target language
+type PipelineStarted struct {
+	BaseEvent
+	TargetLanguage string `json:"target_language"`
+	ResumePhase    string `json:"resume_phase,omitempty"` // First phase run when resuming a failed run
+}
+
+// PipelinePhaseCompleted is a translate full checkpoint: the phase ("sync", "auto"
+// or "apply") finished and is skipped when the run is resumed
+type PipelinePhaseCompleted struct {
+	BaseEvent
+	TargetLanguage  string  `json:"target_language"`
+	Phase           string  `json:"phase"`
+	TaskFile        string  `json:"task_file,omitempty"`
+	DurationSeconds float64 `json:"duration_seconds"`
+}
+
+// PipelineFailed fires when a translate full phase fails (the next run resumes there)
+type PipelineFailed struct {
+	BaseEvent
+	TargetLanguage string `json:"target_language"`
+	Phase          string `json:"phase"`
+	Error          string `json:"error"`
+}
+
+// PipelineCompleted fires when all phases of translate full finished for a target language
+type PipelineCompleted struct {
+	BaseEvent
+	TargetLanguage  string  `json:"target_language"`
+	ItemsTranslated int     `json:"items_translated"`
+	ItemsApplied    int     `json:"items_applied"`
+	CostUSD         float64 `json:"cost_usd"`
+	DurationSeconds float64 `json:"duration_seconds"`
+}
+
+// Review Events (from translate review)
+
+// ExtractionApproved fires when a reviewer signs off a translation
+type ExtractionApproved struct {
+	BaseEvent
+	TaskFile       string `json:"task_file"`
+	FilePath       string `json:"file_path"`
+	ExtractionID   string `json:"extraction_id"`
+	PreviousStatus string `json:"previous_status"`
+	Reviewer       string `json:"reviewer"`
+	TargetText     string `json:"target_text"`
+}
+
+// ExtractionRejected fires when a reviewer discards a translation (it is translated again)
+type ExtractionRejected struct {
+	BaseEvent
+	TaskFile       string `json:"task_file"`
+	FilePath       string `json:"file_path"`
+	ExtractionID   string `json:"extraction_id"`
+	PreviousStatus string `json:"previous_status"`
+	Reviewer       string `json:"reviewer"`
+	RejectedText   string `json:"rejected_text"`
+	Note           string `json:"note,omitempty"`
 }
@@ -0,0 +1,150 @@
+package translate
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// exchangeUnit is one translated unit read back from an exchange file (XLIFF, PO)
+// Units with an ID match one extraction; units without (PO files from elsewhere) match every
+// extraction with the same context and source text
+type exchangeUnit struct {
+	File       string // TaskFile target path
+	ID         string // Extraction ID
+	Context    string
+	SourceText string
+	TargetText string
+	Fuzzy      bool // Translation not confirmed by the reviewer
+	Final      bool // Signed off by the reviewer (XLIFF state "final")
+}
+
+// ImportStats represents statistics from merging an exchange file into a task
+type ImportStats struct {
+	Units     int // Units read from the exchange file
+	Updated   int // Extractions whose target_text changed
+	Unchanged int // Units with the same target_text as the task
+	Untouched int // Units the reviewer left empty (task keeps its value)
+	Stale     int // Units whose source text no longer matches the task
+	Unknown   int // Units with no matching extraction in the task
+}
+
+// ExportTask renders a task in an exchange format for CAT tools
+// Single entry point for task export
+func ExportTask(task *Task, format string) ([]byte, error) {
+	switch format {
+	case "xliff":
+		return exportXLIFF(task)
+	case "po":
+		return exportPO(task)
+	default:
+		return nil, fmt.Errorf("unsupported export format: %s (supported: xliff, po)", format)
+	}
+}
+
+// ImportTranslations merges translations from an exchange file back into a task
+// Single entry point for task import
+// Extractions that are not in the file, or that the reviewer left empty, keep their current target_text
+// Changed translations become reviewed; XLIFF units in state "final" become approved
+func ImportTranslations(task *Task, data []byte, format string) (*ImportStats, error) {
+	var units []exchangeUnit
+	var err error
+
+	switch format {
+	case "xliff":
+		units, err = parseXLIFF(data)
+	case "po":
+		units, err = parsePO(data)
+	default:
+		return nil, fmt.Errorf("unsupported import format: %s (supported: xliff, po)", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Index extractions by file + ID, and by context + source text
+	byID := make(map[string]*TextExtraction)
+	byContent := make(map[string][]*TextExtraction)
+	for fileIdx := range task.Files {
+		file := &task.Files[fileIdx]
+		for extIdx := range file.Extractions {
+			ext := &file.Extractions[extIdx]
+			byID[file.Target+"\x00"+ext.ID] = ext
+			byContent[ext.Context+"\x00"+ext.SourceText] = append(byContent[ext.Context+"\x00"+ext.SourceText], ext)
+		}
+	}
+
+	stats := &ImportStats{Units: len(units)}
+	for _, unit := range units {
+		var matches []*TextExtraction
+		if unit.ID != "" {
+			ext, ok := byID[unit.File+"\x00"+unit.ID]
+			if !ok {
+				stats.Unknown++
+				continue
+			}
+			if unit.SourceText != ext.SourceText {
+				stats.Stale++
+				continue
+			}
+			matches = []*TextExtraction{ext}
+		} else {
+			matches = byContent[unit.Context+"\x00"+unit.SourceText]
+			if len(matches) == 0 {
+				stats.Unknown++
+				continue
+			}
+		}
+
+		if unit.TargetText == "" || unit.Fuzzy {
+			stats.Untouched++
+			continue
+		}
+
+		updated := false
+		for _, ext := range matches {
+			status := StatusReviewed
+			if unit.Final {
+				status = StatusApproved
+			}
+			if ext.TargetText != unit.TargetText || (unit.Final && ExtractionStatus(*ext) != StatusApproved) {
+				ext.TargetText = unit.TargetText
+				ext.Suggestion = ""
+				ext.Status = status
+				ext.Reviewer = ""
+				ext.ReviewedAt = nil
+				ext.BackTranslation, ext.Drift = "", 0
+				updated = true
+			}
+		}
+		if updated {
+			stats.Updated++
+		} else {
+			stats.Unchanged++
+		}
+	}
+
+	return stats, nil
+}
+
+// ExchangeFormatFromPath determines the exchange format from a file extension
+func ExchangeFormatFromPath(path string) (string, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".xlf", ".xliff":
+		return "xliff", nil
+	case ".po":
+		return "po", nil
+	default:
+		return "", fmt.Errorf("cannot determine format of %s (expected .xlf or .po)", path)
+	}
+}
+
+// ExchangeFileExtension returns the file extension used for an exchange format
+func ExchangeFileExtension(format string) string {
+	switch format {
+	case "xliff":
+		return ".xlf"
+	default:
+		return "." + format
+	}
+}
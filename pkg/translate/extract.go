@@ -1,41 +1,135 @@
 package translate
 
 import (
-	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/xml"
 	"fmt"
 	"io"
 	"os"
+	"regexp"
 	"strings"
+	"unicode/utf8"
 )
 
 // ExtractText extracts translatable text from a file
 // Single entry point for text extraction
-func ExtractText(filePath string, fileType string) ([]TextExtraction, error) {
+// config selects the translatable SVG elements and attributes, and the jq
+// paths of JSON documents
+func ExtractText(filePath string, fileType string, config *Config) ([]TextExtraction, error) {
 	switch fileType {
 	case "svg":
-		return extractSVGText(filePath)
+		return extractSVGText(filePath, config.SVG)
 	case "md":
 		return extractMarkdownText(filePath)
+	case "json":
+		doc, ok := findJSONDocument(config, filePath, config.Source.Language)
+		if !ok {
+			return nil, fmt.Errorf("%s is not listed in json_documents", filePath)
+		}
+		return extractJSONText(filePath, doc)
 	default:
 		return nil, fmt.Errorf("unsupported file type: %s", fileType)
 	}
 }
 
+// extractionID builds the stable ID of an extraction from its element path
+// (which already carries sibling indexes) and a short hash of its source text
+func extractionID(path string, sourceText string) string {
+	return path + "#" + sourceHash(sourceText)
+}
+
+// sourceHash returns a short content hash used to detect drifted text
+func sourceHash(text string) string {
+	sum := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(sum[:])[:8]
+}
+
+// splitExtractionID splits an extraction ID into its path and source hash
+func splitExtractionID(id string) (path string, hash string) {
+	idx := strings.LastIndex(id, "#")
+	if idx == -1 {
+		return id, ""
+	}
+	return id[:idx], id[idx+1:]
+}
+
+// textNode is a translatable text node located in a file
+// Spans are byte offsets of the trimmed text, so it can be replaced in place;
+// a unit split over several <tspan> lines has one span per line
+type textNode struct {
+	Path         string
+	Context      string
+	Text         string
+	Line         int
+	Spans        []textSpan
+	Placeholders map[string]string // Protected spans in Text (Markdown only)
+	Indent       string            // Prefix of continuation lines: indentation, > markers (Markdown only)
+}
+
+// textSpan is a byte range [Start, End) in a file
+type textSpan struct {
+	Start int
+	End   int
+}
+
 // extractSVGText extracts translatable text from SVG file
-func extractSVGText(filePath string) ([]TextExtraction, error) {
-	file, err := os.Open(filePath)
+func extractSVGText(filePath string, svg SVGConfig) ([]TextExtraction, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes, err := scanSVGTextNodes(data, svg)
 	if err != nil {
 		return nil, err
 	}
-	defer file.Close()
 
 	var extractions []TextExtraction
-	decoder := xml.NewDecoder(file)
-	lineNum := 1
-	var currentPath []string // Track element path for XPath
+	for _, node := range nodes {
+		extractions = append(extractions, TextExtraction{
+			ID:         extractionID(node.Path, node.Text),
+			Line:       node.Line,
+			XPath:      node.Path,
+			SourceText: node.Text,
+			TargetText: "",
+		})
+	}
+
+	return extractions, nil
+}
+
+// scanSVGTextNodes walks an SVG document and returns every translatable text node
+// Paths use XPath sibling indexes (e.g. /svg/g[3]/text[2]) so that identical
+// labels in different places get different IDs
+// Each configured element is one unit: text in nested <tspan> lines is joined
+// with spaces and keeps one span per line. Configured attributes become
+// units with an attribute path (e.g. /svg/g[3]/@aria-label)
+func scanSVGTextNodes(data []byte, svg SVGConfig) ([]textNode, error) {
+	elements := make(map[string]bool)
+	for _, name := range svg.Elements {
+		elements[name] = true
+	}
+	attributes := make(map[string]*regexp.Regexp)
+	for _, name := range svg.Attributes {
+		attributes[name] = regexp.MustCompile(`\s(?:[\w.-]+:)?` + regexp.QuoteMeta(name) + `\s*=\s*(["'])`)
+	}
+
+	decoder := xml.NewDecoder(bytes.NewReader(data))
 
+	type frame struct {
+		path       string
+		childCount map[string]int
+		unit       bool // This element opened the current unit
+	}
+	stack := []*frame{{childCount: map[string]int{}}}
+
+	var nodes []textNode
+	var unit *textNode // Unit being collected (nested elements add to it)
+	var parts []string
 	for {
+		start := int(decoder.InputOffset())
 		token, err := decoder.Token()
 		if err == io.EOF {
 			break
@@ -43,100 +137,171 @@ func extractSVGText(filePath string) ([]TextExtraction, error) {
 		if err != nil {
 			return nil, err
 		}
+		end := int(decoder.InputOffset())
 
 		switch elem := token.(type) {
 		case xml.StartElement:
-			currentPath = append(currentPath, elem.Name.Local)
-			lineNum++
+			parent := stack[len(stack)-1]
+			parent.childCount[elem.Name.Local]++
+			current := &frame{
+				path:       fmt.Sprintf("%s/%s[%d]", parent.path, elem.Name.Local, parent.childCount[elem.Name.Local]),
+				childCount: map[string]int{},
+			}
+			stack = append(stack, current)
+
+			// Translatable attributes: replace the raw value between the quotes
+			for _, attr := range elem.Attr {
+				pattern, ok := attributes[attr.Name.Local]
+				text := strings.TrimSpace(attr.Value)
+				if !ok || text == "" {
+					continue
+				}
+				raw := data[start:end]
+				match := pattern.FindSubmatchIndex(raw)
+				if match == nil {
+					continue
+				}
+				quote := raw[match[2]]
+				valueEnd := bytes.IndexByte(raw[match[3]:], quote)
+				if valueEnd == -1 {
+					continue
+				}
+				valueStart := start + match[3]
+				nodes = append(nodes, textNode{
+					Path:    current.path + "/@" + attr.Name.Local,
+					Context: "@" + attr.Name.Local,
+					Text:    text,
+					Line:    bytes.Count(data[:valueStart], []byte("\n")) + 1,
+					Spans:   []textSpan{{Start: valueStart, End: valueStart + valueEnd}},
+				})
+			}
+
+			if unit == nil && elements[elem.Name.Local] {
+				unit = &textNode{Path: current.path, Context: elem.Name.Local}
+				current.unit = true
+			}
 
 		case xml.EndElement:
-			if len(currentPath) > 0 {
-				currentPath = currentPath[:len(currentPath)-1]
+			if len(stack) > 1 {
+				if stack[len(stack)-1].unit {
+					if len(unit.Spans) > 0 {
+						unit.Text = strings.Join(parts, " ")
+						unit.Line = bytes.Count(data[:unit.Spans[0].Start], []byte("\n")) + 1
+						nodes = append(nodes, *unit)
+					}
+					unit, parts = nil, nil
+				}
+				stack = stack[:len(stack)-1]
 			}
 
 		case xml.CharData:
 			text := strings.TrimSpace(string(elem))
-			if text == "" {
+			if unit == nil || text == "" {
 				continue
 			}
 
-			// Only extract from text and title elements
-			if len(currentPath) > 0 {
-				lastElem := currentPath[len(currentPath)-1]
-				if lastElem == "text" || lastElem == "title" {
-					xpath := "/" + strings.Join(currentPath, "/")
-					extractions = append(extractions, TextExtraction{
-						Line:       lineNum,
-						XPath:      xpath,
-						SourceText: text,
-						TargetText: "",
-					})
-				}
-			}
+			// Narrow the raw byte range to the trimmed text (keeps indentation)
+			raw := data[start:end]
+			trimStart := start + (len(raw) - len(bytes.TrimLeft(raw, " \t\r\n")))
+			trimEnd := start + len(bytes.TrimRight(raw, " \t\r\n"))
+
+			unit.Spans = append(unit.Spans, textSpan{Start: trimStart, End: trimEnd})
+			parts = append(parts, text)
 		}
 	}
 
-	return extractions, nil
+	return nodes, nil
 }
 
-// extractMarkdownText extracts translatable text from Markdown file
-func extractMarkdownText(filePath string) ([]TextExtraction, error) {
-	file, err := os.Open(filePath)
-	if err != nil {
-		return nil, err
+// renderSVGText escapes a translation for an SVG unit, one replacement per span
+// Multi-line units get the translation spread back over their <tspan> lines
+func renderSVGText(node textNode, translation string) ([]string, error) {
+	lines := []string{translation}
+	if len(node.Spans) > 1 {
+		lines = distributeLines(node, translation)
 	}
-	defer file.Close()
 
-	var extractions []TextExtraction
-	scanner := bufio.NewScanner(file)
-	lineNum := 0
-	inCodeBlock := false
-
-	for scanner.Scan() {
-		lineNum++
-		line := scanner.Text()
-		trimmed := strings.TrimSpace(line)
-
-		// Skip empty lines
-		if trimmed == "" {
-			continue
+	replacements := make([]string, len(lines))
+	for i, line := range lines {
+		var buf bytes.Buffer
+		if err := xml.EscapeText(&buf, []byte(line)); err != nil {
+			return nil, err
 		}
+		replacements[i] = buf.String()
+	}
+	return replacements, nil
+}
 
-		// Track code blocks
-		if strings.HasPrefix(trimmed, "```") {
-			inCodeBlock = !inCodeBlock
-			continue
+// distributeLines splits a translation over the spans of a multi-line unit
+// A translation with exactly one line per span keeps its own line breaks;
+// otherwise words (Thai split by the word segmenter) are spread in proportion
+// to the source line lengths. Lines left without words stay empty
+func distributeLines(node textNode, translation string) []string {
+	if rows := strings.Split(translation, "\n"); len(rows) == len(node.Spans) {
+		for i := range rows {
+			rows[i] = strings.TrimSpace(rows[i])
 		}
+		return rows
+	}
 
-		// Skip code blocks
-		if inCodeBlock {
-			continue
-		}
+	// Source line lengths weight the split
+	total := 0
+	for _, span := range node.Spans {
+		total += span.End - span.Start
+	}
+
+	words := breakTokens(strings.TrimSpace(translation))
+	wordsLen := 0
+	for _, word := range words {
+		wordsLen += utf8.RuneCountInString(word)
+	}
 
-		// Determine context
-		context := "paragraph"
-		displayText := trimmed
+	lines := make([]string, len(node.Spans))
+	next, used, cumulative := 0, 0, 0
+	for i, span := range node.Spans {
+		cumulative += span.End - span.Start
+		last := i == len(lines)-1
+		remainingLines := len(lines) - i - 1
 
-		if strings.HasPrefix(trimmed, "#") {
-			context = "heading"
-		} else if strings.HasPrefix(trimmed, "- ") || strings.HasPrefix(trimmed, "* ") {
-			context = "list-item"
-		} else if strings.HasPrefix(trimmed, ">") {
-			context = "blockquote"
+		var line strings.Builder
+		for next < len(words) {
+			if line.Len() > 0 && !last && (used >= wordsLen*cumulative/total || len(words)-next <= remainingLines) {
+				break
+			}
+			line.WriteString(words[next])
+			used += utf8.RuneCountInString(words[next])
+			next++
 		}
+		lines[i] = strings.TrimSpace(line.String())
+	}
 
-		// Extract text (keep markdown formatting like **bold**)
-		extractions = append(extractions, TextExtraction{
-			Line:       lineNum,
-			Context:    context,
-			SourceText: displayText,
-			TargetText: "",
-		})
+	return lines
+}
+
+// extractMarkdownText extracts translatable text from Markdown file
+func extractMarkdownText(filePath string) ([]TextExtraction, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
 	}
 
-	if err := scanner.Err(); err != nil {
+	nodes, err := scanMarkdownTextNodes(data)
+	if err != nil {
 		return nil, err
 	}
 
+	var extractions []TextExtraction
+	for _, node := range nodes {
+		// Extract text (keep inline formatting like **bold**, protect URLs and code)
+		extractions = append(extractions, TextExtraction{
+			ID:           extractionID(node.Path, node.Text),
+			Line:         node.Line,
+			Context:      node.Context,
+			SourceText:   node.Text,
+			TargetText:   "",
+			Placeholders: node.Placeholders,
+		})
+	}
+
 	return extractions, nil
 }
@@ -0,0 +1,931 @@
+package translate
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Typography is the typography section of drawing-standards.json
+type Typography struct {
+	FontFamilies map[string]FontFamily `json:"fontFamilies"`
+	FontSizes    map[string]float64    `json:"fontSizes"`
+	LineHeight   float64               `json:"lineHeight"` // Line spacing of wrapped labels in em (default 1.2)
+}
+
+// FontFamily is one entry of typography.fontFamilies
+type FontFamily struct {
+	Name     string `json:"name"`
+	Fallback string `json:"fallback"`
+}
+
+// FitIssue is a translated label that no longer fits where the source label did
+type FitIssue struct {
+	Path        string  // XPath of the <text> element
+	Text        string  // Translated label
+	Kind        string  // "overflow" (leaves the drawing) or "collision"
+	With        string  // What the label runs into ("rect.wall-exterior", "label \"Door\"")
+	SourceWidth float64 // Estimated width of the source label
+	TargetWidth float64 // Estimated width of the translated label
+	FontSize    float64
+	Fix         string // How --fix resolved it (empty if unresolved)
+}
+
+// DrawingFit is the text-fit result for one translated drawing
+type DrawingFit struct {
+	Source string
+	Target string
+	Labels int // Labels measured
+	Issues []FitIssue
+	Fixed  int // Issues resolved by --fix
+}
+
+// Unresolved returns the number of issues --fix did not resolve
+func (d *DrawingFit) Unresolved() int {
+	return len(d.Issues) - d.Fixed
+}
+
+// DrawingPair is a source drawing and its translation (paths relative to the root)
+type DrawingPair struct {
+	Source string
+	Target string
+}
+
+// LoadTypography reads the typography section of drawing-standards.json
+// Without a standards file labels are measured as Arial with no font-size steps
+// and a line height of 1.2
+func LoadTypography(standardsPath string) (*Typography, error) {
+	typography := &Typography{}
+	data, err := os.ReadFile(standardsPath)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read drawing standards: %w", err)
+	}
+	if err == nil {
+		var standards struct {
+			DrawingStandards struct {
+				Typography *Typography `json:"typography"`
+			} `json:"drawingStandards"`
+		}
+		if err := json.Unmarshal(data, &standards); err != nil {
+			return nil, fmt.Errorf("failed to parse drawing standards: %w", err)
+		}
+		if standards.DrawingStandards.Typography != nil {
+			typography = standards.DrawingStandards.Typography
+		}
+	}
+
+	if _, ok := typography.FontFamilies["primary"]; !ok {
+		if typography.FontFamilies == nil {
+			typography.FontFamilies = make(map[string]FontFamily)
+		}
+		typography.FontFamilies["primary"] = FontFamily{Name: "Arial"}
+	}
+	if typography.LineHeight == 0 {
+		typography.LineHeight = 1.2
+	}
+	return typography, nil
+}
+
+// fontSteps returns the permitted font sizes, largest first
+func (t *Typography) fontSteps() []float64 {
+	var steps []float64
+	for _, size := range t.FontSizes {
+		steps = append(steps, size)
+	}
+	sort.Sort(sort.Reverse(sort.Float64Slice(steps)))
+	return steps
+}
+
+// TranslatedDrawings lists the translated SVG drawings of a target with their sources
+func TranslatedDrawings(rootDir string, config *Config, target TargetConfig) ([]DrawingPair, error) {
+	var pairs []DrawingPair
+	for _, folder := range config.FolderMappings(target) {
+		targetDir := filepath.Join(rootDir, folder.Target)
+		if _, err := os.Stat(targetDir); os.IsNotExist(err) {
+			continue
+		}
+
+		err := filepath.Walk(targetDir, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() || determineFileType(path) != "svg" {
+				return nil
+			}
+			relTarget, err := filepath.Rel(rootDir, path)
+			if err != nil {
+				return err
+			}
+			relSource, err := deriveSourcePath(relTarget, config, target)
+			if err != nil {
+				return err
+			}
+			if _, err := os.Stat(filepath.Join(rootDir, relSource)); err == nil {
+				pairs = append(pairs, DrawingPair{Source: relSource, Target: relTarget})
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan %s: %w", folder.Target, err)
+		}
+	}
+	return pairs, nil
+}
+
+// CheckTextFit measures every label of a translated drawing against its source label
+// Single entry point for text-fit checking
+// Widths are estimated from font metrics (font-size, font-weight and text-anchor from
+// attributes, inline styles and <style> class rules). An issue is a translated label
+// that leaves the drawing or runs into geometry or another label where the source
+// label did not. With fix, each issue is resolved by shrinking the label to a
+// permitted typography.fontSizes step, or by wrapping it into <tspan> lines, and the
+// translated drawing is rewritten
+func CheckTextFit(rootDir string, pair DrawingPair, typography *Typography, fix bool) (*DrawingFit, error) {
+	sourceData, err := os.ReadFile(filepath.Join(rootDir, pair.Source))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", pair.Source, err)
+	}
+	targetPath := filepath.Join(rootDir, pair.Target)
+	targetData, err := os.ReadFile(targetPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", pair.Target, err)
+	}
+
+	source, err := scanSVGLayout(sourceData, typography)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", pair.Source, err)
+	}
+	target, err := scanSVGLayout(targetData, typography)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", pair.Target, err)
+	}
+
+	// Problems the source labels already have are part of the drawing, not of the translation
+	sourceLabels := make(map[string]*svgLabel)
+	known := make(map[string]bool)
+	for _, label := range source.Labels {
+		sourceLabels[label.Path] = label
+		for _, p := range source.problems(label) {
+			known[label.Path+" "+p.key] = true
+		}
+	}
+
+	result := &DrawingFit{Source: pair.Source, Target: pair.Target, Labels: len(target.Labels)}
+	newProblems := func(label *svgLabel) []fitProblem {
+		var found []fitProblem
+		for _, p := range target.problems(label) {
+			if !known[label.Path+" "+p.key] {
+				found = append(found, p)
+			}
+		}
+		return found
+	}
+
+	var edits []textEdit
+	for _, label := range target.Labels {
+		problems := newProblems(label)
+		if len(problems) == 0 {
+			continue
+		}
+
+		sourceWidth := 0.0
+		if src := sourceLabels[label.Path]; src != nil {
+			sourceWidth = src.width()
+		}
+		fixDescription := ""
+		if fix {
+			fixDescription = fixLabel(label, sourceWidth, typography, func() bool { return len(newProblems(label)) == 0 })
+			if fixDescription != "" {
+				edits = append(edits, label.edits()...)
+			}
+		}
+
+		for _, p := range problems {
+			issue := FitIssue{
+				Path:        label.Path,
+				Text:        label.Text,
+				Kind:        p.kind,
+				With:        p.with,
+				SourceWidth: sourceWidth,
+				TargetWidth: label.original.width,
+				FontSize:    label.original.fontSize,
+				Fix:         fixDescription,
+			}
+			if fixDescription != "" {
+				result.Fixed++
+			}
+			result.Issues = append(result.Issues, issue)
+		}
+	}
+
+	if len(edits) > 0 {
+		if err := os.WriteFile(targetPath, applyTextEdits(targetData, edits), 0644); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", pair.Target, err)
+		}
+	}
+
+	return result, nil
+}
+
+// fixLabel tries, in order: each smaller font-size step, wrapping at the current
+// size, and wrapping at each smaller step. The first candidate for which fits
+// reports true is kept and described; otherwise the label is restored
+func fixLabel(label *svgLabel, sourceWidth float64, typography *Typography, fits func() bool) string {
+	original := label.FontSize
+	originalLines := label.Lines
+
+	var smaller []float64
+	for _, step := range typography.fontSteps() {
+		if step < original {
+			smaller = append(smaller, step)
+		}
+	}
+
+	for _, size := range smaller {
+		label.FontSize = size
+		if fits() {
+			return fmt.Sprintf("font-size %s", formatNumber(size))
+		}
+	}
+
+	// Wrapping keeps the label within the width of the source label
+	if len(originalLines) == 1 {
+		maxWidth := sourceWidth
+		if maxWidth == 0 {
+			maxWidth = label.original.width / 2
+		}
+		for _, size := range append([]float64{original}, smaller...) {
+			label.FontSize = size
+			rows := wrapText(label.Text, maxWidth, label.measure)
+			if len(rows) < 2 {
+				continue
+			}
+			label.Lines = label.wrappedLines(rows, typography.LineHeight)
+			label.lineHeight = typography.LineHeight
+			if fits() {
+				if size == original {
+					return fmt.Sprintf("wrapped into %d lines", len(rows))
+				}
+				return fmt.Sprintf("wrapped into %d lines at font-size %s", len(rows), formatNumber(size))
+			}
+		}
+	}
+
+	label.FontSize = original
+	label.Lines = originalLines
+	label.lineHeight = 0
+	return ""
+}
+
+// Layout model
+
+// svgLayout is what the fit checker knows about a drawing: its canvas, labels and geometry
+type svgLayout struct {
+	Bounds *fitBox // viewBox (nil if the drawing has no size)
+	Labels []*svgLabel
+	Shapes []svgShape
+}
+
+// svgLabel is one rendered <text> element
+type svgLabel struct {
+	Path      string
+	Text      string
+	Lines     []labelLine
+	FontSize  float64
+	Bold      bool
+	Anchor    string // "start", "middle" or "end"
+	Transform affine
+	metrics   *fontMetrics
+
+	startTag   [2]int  // Byte range of the <text ...> start tag
+	content    [2]int  // Byte range between the start and end tag
+	lineHeight float64 // Set when --fix wrapped the label into <tspan> lines (em)
+	original   struct {
+		fontSize float64
+		width    float64
+	}
+}
+
+// labelLine is one line of a label (the text itself or a <tspan>)
+type labelLine struct {
+	Text string
+	X, Y float64
+}
+
+// svgShape is drawn geometry as line segments in drawing coordinates
+type svgShape struct {
+	Name     string // "rect.wall-exterior", "line.door", ...
+	Segments [][4]float64
+}
+
+// fitProblem is one overflow or collision of a label
+type fitProblem struct {
+	kind string
+	with string
+	key  string // Identifies the obstacle across source and target
+}
+
+// fitBox is an axis-aligned bounding box
+type fitBox struct {
+	X1, Y1, X2, Y2 float64
+}
+
+func (b fitBox) overlaps(o fitBox) bool {
+	return b.X1 < o.X2 && o.X1 < b.X2 && b.Y1 < o.Y2 && o.Y1 < b.Y2
+}
+
+func (b fitBox) inside(o fitBox) bool {
+	return b.X1 >= o.X1 && b.X2 <= o.X2 && b.Y1 >= o.Y1 && b.Y2 <= o.Y2
+}
+
+// crossesSegment reports whether a line segment passes through the box (Liang-Barsky clipping)
+func (b fitBox) crossesSegment(s [4]float64) bool {
+	t0, t1 := 0.0, 1.0
+	dx, dy := s[2]-s[0], s[3]-s[1]
+	for _, edge := range [][2]float64{
+		{-dx, s[0] - b.X1}, {dx, b.X2 - s[0]},
+		{-dy, s[1] - b.Y1}, {dy, b.Y2 - s[1]},
+	} {
+		p, q := edge[0], edge[1]
+		if p == 0 {
+			if q < 0 {
+				return false
+			}
+			continue
+		}
+		t := q / p
+		if p < 0 {
+			t0 = math.Max(t0, t)
+		} else {
+			t1 = math.Min(t1, t)
+		}
+		if t0 > t1 {
+			return false
+		}
+	}
+	return true
+}
+
+// measure returns the advance width of a string in the label's font
+func (l *svgLabel) measure(s string) float64 {
+	return l.metrics.width(s, l.FontSize, l.Bold)
+}
+
+// width returns the width of the widest line
+func (l *svgLabel) width() float64 {
+	widest := 0.0
+	for _, line := range l.Lines {
+		widest = math.Max(widest, l.measure(line.Text))
+	}
+	return widest
+}
+
+// box returns the label's bounding box in drawing coordinates
+// Thai marks above and below the line get extra ascent and descent
+func (l *svgLabel) box() fitBox {
+	ascent, descent := 0.8, 0.2
+	if strings.IndexFunc(l.Text, isThaiCombining) >= 0 {
+		ascent, descent = 0.95, 0.35
+	}
+
+	box := fitBox{X1: math.Inf(1), Y1: math.Inf(1), X2: math.Inf(-1), Y2: math.Inf(-1)}
+	for _, line := range l.Lines {
+		w := l.measure(line.Text)
+		x := line.X
+		switch l.Anchor {
+		case "middle":
+			x -= w / 2
+		case "end":
+			x -= w
+		}
+		for _, corner := range [][2]float64{
+			{x, line.Y - ascent*l.FontSize}, {x + w, line.Y - ascent*l.FontSize},
+			{x, line.Y + descent*l.FontSize}, {x + w, line.Y + descent*l.FontSize},
+		} {
+			cx, cy := l.Transform.apply(corner[0], corner[1])
+			box.X1, box.Y1 = math.Min(box.X1, cx), math.Min(box.Y1, cy)
+			box.X2, box.Y2 = math.Max(box.X2, cx), math.Max(box.Y2, cy)
+		}
+	}
+	return box
+}
+
+// wrappedLines lays out wrapped rows centred on the original baseline (as renderTspans writes them)
+func (l *svgLabel) wrappedLines(rows []string, lineHeightEm float64) []labelLine {
+	lineHeight := lineHeightEm * l.FontSize
+	first := l.Lines[0]
+	top := first.Y - float64(len(rows)-1)*lineHeight/2
+	lines := make([]labelLine, len(rows))
+	for i, row := range rows {
+		lines[i] = labelLine{Text: row, X: first.X, Y: top + float64(i)*lineHeight}
+	}
+	return lines
+}
+
+// problems returns the overflows and collisions of a label in its drawing
+func (layout *svgLayout) problems(label *svgLabel) []fitProblem {
+	var problems []fitProblem
+	box := label.box()
+
+	if layout.Bounds != nil && !box.inside(*layout.Bounds) {
+		problems = append(problems, fitProblem{kind: "overflow", with: "drawing edge", key: "edge"})
+	}
+
+	for _, shape := range layout.Shapes {
+		for _, segment := range shape.Segments {
+			if box.crossesSegment(segment) {
+				problems = append(problems, fitProblem{kind: "collision", with: shape.Name, key: "shape " + shape.Name + fmt.Sprint(segment)})
+				break
+			}
+		}
+	}
+
+	for _, other := range layout.Labels {
+		if other != label && box.overlaps(other.box()) {
+			problems = append(problems, fitProblem{kind: "collision", with: fmt.Sprintf("label %q", other.Text), key: "label " + other.Path})
+		}
+	}
+
+	return problems
+}
+
+// edits returns the byte edits that write a fixed label back to the drawing
+func (l *svgLabel) edits() []textEdit {
+	var edits []textEdit
+	if l.FontSize != l.original.fontSize {
+		edits = append(edits, textEdit{start: l.startTag[0], end: l.startTag[1], replace: func(tag []byte) []byte {
+			return setInlineFontSize(tag, l.FontSize)
+		}})
+	}
+	if l.lineHeight > 0 {
+		rows := make([]string, len(l.Lines))
+		for i, line := range l.Lines {
+			rows[i] = line.Text
+		}
+		content, err := renderTspans(rows, l.Lines[0].X, l.lineHeight)
+		if err == nil {
+			edits = append(edits, textEdit{start: l.content[0], end: l.content[1], replace: func([]byte) []byte {
+				return []byte(content)
+			}})
+		}
+	}
+	return edits
+}
+
+// textEdit replaces a byte range of a document
+type textEdit struct {
+	start, end int
+	replace    func(original []byte) []byte
+}
+
+// applyTextEdits applies non-overlapping edits, last first so offsets stay valid
+func applyTextEdits(data []byte, edits []textEdit) []byte {
+	sort.Slice(edits, func(i, j int) bool { return edits[i].start > edits[j].start })
+	result := append([]byte(nil), data...)
+	for _, edit := range edits {
+		replacement := edit.replace(result[edit.start:edit.end])
+		result = append(result[:edit.start], append(replacement, result[edit.end:]...)...)
+	}
+	return result
+}
+
+var (
+	styleAttrPattern = regexp.MustCompile(`\sstyle\s*=\s*(["'])(.*?)(["'])`)
+	fontSizeDecl     = regexp.MustCompile(`font-size\s*:\s*[^;]*`)
+)
+
+// setInlineFontSize sets font-size in the style attribute of a start tag
+// (inline style wins over class rules and the font-size attribute)
+func setInlineFontSize(tag []byte, size float64) []byte {
+	decl := "font-size: " + formatNumber(size) + "px"
+	if match := styleAttrPattern.FindSubmatchIndex(tag); match != nil {
+		style := string(tag[match[4]:match[5]])
+		if fontSizeDecl.MatchString(style) {
+			style = fontSizeDecl.ReplaceAllString(style, decl)
+		} else {
+			style = strings.TrimSuffix(strings.TrimSpace(style), ";")
+			if style != "" {
+				style += "; "
+			}
+			style += decl
+		}
+		return append(append(append([]byte(nil), tag[:match[4]]...), style...), tag[match[5]:]...)
+	}
+
+	closing := bytes.LastIndex(tag, []byte(">"))
+	if bytes.HasSuffix(bytes.TrimSpace(tag[:closing]), []byte("/")) {
+		closing = bytes.LastIndex(tag[:closing], []byte("/"))
+	}
+	insert := []byte(` style="` + decl + `"`)
+	return append(append(append([]byte(nil), tag[:closing]...), insert...), tag[closing:]...)
+}
+
+// formatNumber prints a coordinate or size without trailing zeros (12, 137.5)
+func formatNumber(v float64) string {
+	return strconv.FormatFloat(math.Round(v*10)/10, 'f', -1, 64)
+}
+
+// Scanning
+
+// textStyle is the inherited text style of an element
+type textStyle struct {
+	fontSize float64
+	family   string
+	bold     bool
+	anchor   string
+}
+
+var (
+	styleBlockPattern = regexp.MustCompile(`(?s)<style[^>]*>(.*?)</style>`)
+	cssRulePattern    = regexp.MustCompile(`(?s)([^{}]+)\{([^}]*)\}`)
+	cssCommentPattern = regexp.MustCompile(`(?s)/\*.*?\*/`)
+)
+
+// parseClassRules reads the .class rules of every <style> block as class → declarations
+func parseClassRules(data []byte) map[string]map[string]string {
+	rules := make(map[string]map[string]string)
+	for _, block := range styleBlockPattern.FindAllSubmatch(data, -1) {
+		css := strings.NewReplacer("<![CDATA[", "", "]]>", "").Replace(string(block[1]))
+		css = cssCommentPattern.ReplaceAllString(css, "")
+		for _, rule := range cssRulePattern.FindAllStringSubmatch(css, -1) {
+			declarations := parseDeclarations(rule[2])
+			for _, selector := range strings.Split(rule[1], ",") {
+				selector = strings.TrimSpace(selector)
+				if !strings.HasPrefix(selector, ".") || strings.ContainsAny(selector[1:], " .:#>[") {
+					continue
+				}
+				class := selector[1:]
+				if rules[class] == nil {
+					rules[class] = make(map[string]string)
+				}
+				for name, value := range declarations {
+					rules[class][name] = value
+				}
+			}
+		}
+	}
+	return rules
+}
+
+// parseDeclarations parses "name: value; ..." into a map
+func parseDeclarations(css string) map[string]string {
+	declarations := make(map[string]string)
+	for _, decl := range strings.Split(css, ";") {
+		name, value, ok := strings.Cut(decl, ":")
+		if ok {
+			declarations[strings.TrimSpace(name)] = strings.TrimSpace(value)
+		}
+	}
+	return declarations
+}
+
+// resolve applies an element's presentation attributes, class rules and inline
+// style (in increasing priority) on top of the inherited style
+func (s textStyle) resolve(elem xml.StartElement, rules map[string]map[string]string) textStyle {
+	properties := make(map[string]string)
+	for _, attr := range elem.Attr {
+		switch attr.Name.Local {
+		case "font-size", "font-family", "font-weight", "text-anchor":
+			properties[attr.Name.Local] = attr.Value
+		}
+	}
+	for _, class := range strings.Fields(svgAttr(elem, "class")) {
+		for name, value := range rules[class] {
+			properties[name] = value
+		}
+	}
+	for name, value := range parseDeclarations(svgAttr(elem, "style")) {
+		properties[name] = value
+	}
+
+	if v, ok := properties["font-size"]; ok {
+		if size, ok := parseLength(v, s.fontSize); ok {
+			s.fontSize = size
+		}
+	}
+	if v, ok := properties["font-family"]; ok {
+		s.family = strings.Trim(strings.TrimSpace(strings.Split(v, ",")[0]), `"'`)
+	}
+	if v, ok := properties["font-weight"]; ok {
+		weight, err := strconv.Atoi(v)
+		s.bold = v == "bold" || v == "bolder" || (err == nil && weight >= 600)
+	}
+	if v, ok := properties["text-anchor"]; ok {
+		s.anchor = v
+	}
+	return s
+}
+
+// scanSVGLayout reads the canvas, labels and geometry of a drawing
+// Paths match scanSVGTextNodes so source and translated labels pair up.
+// Text in <defs> is not rendered and is skipped; <tspan>s use the font of their <text>
+func scanSVGLayout(data []byte, typography *Typography) (*svgLayout, error) {
+	rules := parseClassRules(data)
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+
+	type frame struct {
+		path       string
+		name       string
+		childCount map[string]int
+		style      textStyle
+		transform  affine
+		hidden     bool // Inside <defs>
+	}
+	stack := []*frame{{
+		childCount: map[string]int{},
+		style:      textStyle{fontSize: 16, family: typography.FontFamilies["primary"].Name, anchor: "start"},
+		transform:  identity,
+	}}
+
+	layout := &svgLayout{}
+	var label *svgLabel
+	var line *labelLine // Line collecting text (nil until the first text or <tspan>)
+	var cursorY float64
+	for {
+		start := int(decoder.InputOffset())
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		end := int(decoder.InputOffset())
+
+		switch elem := token.(type) {
+		case xml.StartElement:
+			parent := stack[len(stack)-1]
+			parent.childCount[elem.Name.Local]++
+			current := &frame{
+				path:       fmt.Sprintf("%s/%s[%d]", parent.path, elem.Name.Local, parent.childCount[elem.Name.Local]),
+				name:       elem.Name.Local,
+				childCount: map[string]int{},
+				style:      parent.style.resolve(elem, rules),
+				transform:  parent.transform.multiply(parseTransform(svgAttr(elem, "transform"))),
+				hidden:     parent.hidden || elem.Name.Local == "defs",
+			}
+			stack = append(stack, current)
+			if current.hidden {
+				continue
+			}
+
+			switch elem.Name.Local {
+			case "svg":
+				if layout.Bounds == nil {
+					layout.Bounds = parseCanvas(elem)
+				}
+			case "text":
+				if label != nil {
+					continue
+				}
+				x, _ := parseLength(firstValue(svgAttr(elem, "x")), 0)
+				y, _ := parseLength(firstValue(svgAttr(elem, "y")), 0)
+				label = &svgLabel{
+					Path:      current.path,
+					FontSize:  current.style.fontSize,
+					Bold:      current.style.bold,
+					Anchor:    current.style.anchor,
+					Transform: current.transform,
+					metrics:   metricsForFont(current.style.family),
+					startTag:  [2]int{start, end},
+				}
+				label.content[0] = end
+				line = &labelLine{X: x, Y: y}
+				cursorY = y
+			case "tspan":
+				if label == nil || line == nil {
+					continue
+				}
+				next := labelLine{X: line.X, Y: cursorY}
+				if v, ok := parseLength(firstValue(svgAttr(elem, "x")), 0); ok && svgAttr(elem, "x") != "" {
+					next.X = v
+				}
+				if v, ok := parseLength(firstValue(svgAttr(elem, "y")), 0); ok && svgAttr(elem, "y") != "" {
+					next.Y = v
+				}
+				if v, ok := parseLength(firstValue(svgAttr(elem, "dy")), label.FontSize); ok && svgAttr(elem, "dy") != "" {
+					next.Y += v
+				}
+				cursorY = next.Y
+				if strings.TrimSpace(line.Text) != "" {
+					label.Lines = append(label.Lines, *line)
+				}
+				line = &next
+			default:
+				if label == nil {
+					if shape, ok := parseShape(elem, current.transform); ok {
+						shape.Name = elem.Name.Local
+						if class := svgAttr(elem, "class"); class != "" {
+							shape.Name += "." + strings.Fields(class)[0]
+						}
+						layout.Shapes = append(layout.Shapes, shape)
+					}
+				}
+			}
+
+		case xml.EndElement:
+			if len(stack) > 1 {
+				current := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				if label != nil && current.name == "text" && current.path == label.Path {
+					if strings.TrimSpace(line.Text) != "" {
+						label.Lines = append(label.Lines, *line)
+					}
+					label.content[1] = start
+					var parts []string
+					for i := range label.Lines {
+						label.Lines[i].Text = strings.Join(strings.Fields(label.Lines[i].Text), " ")
+						parts = append(parts, label.Lines[i].Text)
+					}
+					label.Text = strings.Join(parts, " ")
+					if label.Text != "" {
+						label.original.fontSize = label.FontSize
+						label.original.width = label.width()
+						layout.Labels = append(layout.Labels, label)
+					}
+					label, line = nil, nil
+				}
+			}
+
+		case xml.CharData:
+			if label != nil && line != nil {
+				line.Text += " " + string(elem)
+			}
+		}
+	}
+
+	return layout, nil
+}
+
+// parseShape turns rect, line, polyline and polygon elements into segments
+func parseShape(elem xml.StartElement, transform affine) (svgShape, bool) {
+	num := func(name string) float64 {
+		v, _ := parseLength(svgAttr(elem, name), 0)
+		return v
+	}
+
+	var points [][2]float64
+	closed := false
+	switch elem.Name.Local {
+	case "rect":
+		x, y, w, h := num("x"), num("y"), num("width"), num("height")
+		points = [][2]float64{{x, y}, {x + w, y}, {x + w, y + h}, {x, y + h}}
+		closed = true
+	case "line":
+		points = [][2]float64{{num("x1"), num("y1")}, {num("x2"), num("y2")}}
+	case "polyline", "polygon":
+		fields := strings.FieldsFunc(svgAttr(elem, "points"), func(r rune) bool { return r == ',' || r == ' ' || r == '\n' || r == '\t' })
+		for i := 0; i+1 < len(fields); i += 2 {
+			x, _ := strconv.ParseFloat(fields[i], 64)
+			y, _ := strconv.ParseFloat(fields[i+1], 64)
+			points = append(points, [2]float64{x, y})
+		}
+		closed = elem.Name.Local == "polygon"
+	default:
+		return svgShape{}, false
+	}
+	if len(points) < 2 {
+		return svgShape{}, false
+	}
+
+	for i := range points {
+		points[i][0], points[i][1] = transform.apply(points[i][0], points[i][1])
+	}
+	shape := svgShape{}
+	for i := 0; i+1 < len(points); i++ {
+		shape.Segments = append(shape.Segments, [4]float64{points[i][0], points[i][1], points[i+1][0], points[i+1][1]})
+	}
+	if closed {
+		last := points[len(points)-1]
+		shape.Segments = append(shape.Segments, [4]float64{last[0], last[1], points[0][0], points[0][1]})
+	}
+	return shape, true
+}
+
+// parseCanvas returns the drawing area from viewBox (or width/height)
+func parseCanvas(elem xml.StartElement) *fitBox {
+	if fields := strings.FieldsFunc(svgAttr(elem, "viewBox"), func(r rune) bool { return r == ',' || r == ' ' }); len(fields) == 4 {
+		var v [4]float64
+		for i, field := range fields {
+			v[i], _ = strconv.ParseFloat(field, 64)
+		}
+		return &fitBox{X1: v[0], Y1: v[1], X2: v[0] + v[2], Y2: v[1] + v[3]}
+	}
+	w, okW := parseLength(svgAttr(elem, "width"), 0)
+	h, okH := parseLength(svgAttr(elem, "height"), 0)
+	if okW && okH && w > 0 && h > 0 {
+		return &fitBox{X2: w, Y2: h}
+	}
+	return nil
+}
+
+// svgAttr returns an attribute value by local name
+func svgAttr(elem xml.StartElement, name string) string {
+	for _, attr := range elem.Attr {
+		if attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+// firstValue returns the first value of a coordinate list ("10 20 30" -> "10")
+func firstValue(s string) string {
+	fields := strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == ' ' })
+	if len(fields) == 0 {
+		return ""
+	}
+	return fields[0]
+}
+
+// parseLength parses a length in user units ("14", "14px", "1.2em" relative to em)
+func parseLength(s string, em float64) (float64, bool) {
+	s = strings.TrimSpace(s)
+	scale := 1.0
+	switch {
+	case strings.HasSuffix(s, "px"):
+		s = strings.TrimSuffix(s, "px")
+	case strings.HasSuffix(s, "pt"):
+		s, scale = strings.TrimSuffix(s, "pt"), 4.0/3.0
+	case strings.HasSuffix(s, "em"):
+		s, scale = strings.TrimSuffix(s, "em"), em
+	}
+	v, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil {
+		return 0, false
+	}
+	return v * scale, true
+}
+
+// affine is an SVG transform matrix (a b c d e f)
+type affine [6]float64
+
+var identity = affine{1, 0, 0, 1, 0, 0}
+
+// apply transforms a point
+func (m affine) apply(x, y float64) (float64, float64) {
+	return m[0]*x + m[2]*y + m[4], m[1]*x + m[3]*y + m[5]
+}
+
+// multiply returns m followed by n (n applies first, as for nested elements)
+func (m affine) multiply(n affine) affine {
+	return affine{
+		m[0]*n[0] + m[2]*n[1], m[1]*n[0] + m[3]*n[1],
+		m[0]*n[2] + m[2]*n[3], m[1]*n[2] + m[3]*n[3],
+		m[0]*n[4] + m[2]*n[5] + m[4], m[1]*n[4] + m[3]*n[5] + m[5],
+	}
+}
+
+var transformPattern = regexp.MustCompile(`(\w+)\s*\(([^)]*)\)`)
+
+// parseTransform parses a transform attribute (translate, scale, rotate, matrix, skewX, skewY)
+func parseTransform(s string) affine {
+	result := identity
+	for _, match := range transformPattern.FindAllStringSubmatch(s, -1) {
+		var args []float64
+		for _, field := range strings.FieldsFunc(match[2], func(r rune) bool { return r == ',' || r == ' ' }) {
+			v, _ := strconv.ParseFloat(field, 64)
+			args = append(args, v)
+		}
+		arg := func(i int, fallback float64) float64 {
+			if i < len(args) {
+				return args[i]
+			}
+			return fallback
+		}
+
+		var m affine
+		switch match[1] {
+		case "translate":
+			m = affine{1, 0, 0, 1, arg(0, 0), arg(1, 0)}
+		case "scale":
+			m = affine{arg(0, 1), 0, 0, arg(1, arg(0, 1)), 0, 0}
+		case "rotate":
+			rad := arg(0, 0) * math.Pi / 180
+			cos, sin := math.Cos(rad), math.Sin(rad)
+			cx, cy := arg(1, 0), arg(2, 0)
+			m = affine{1, 0, 0, 1, cx, cy}.
+				multiply(affine{cos, sin, -sin, cos, 0, 0}).
+				multiply(affine{1, 0, 0, 1, -cx, -cy})
+		case "skewX":
+			m = affine{1, 0, math.Tan(arg(0, 0) * math.Pi / 180), 1, 0, 0}
+		case "skewY":
+			m = affine{1, math.Tan(arg(0, 0) * math.Pi / 180), 0, 1, 0, 0}
+		case "matrix":
+			m = affine{arg(0, 1), arg(1, 0), arg(2, 0), arg(3, 1), arg(4, 0), arg(5, 0)}
+		default:
+			continue
+		}
+		result = result.multiply(m)
+	}
+	return result
+}
@@ -0,0 +1,103 @@
+package translate
+
+import (
+	"strings"
+	"unicode"
+)
+
+// fontMetrics estimates text advance widths in 1/1000 em
+// Latin widths are the Adobe AFM widths of Helvetica (metric-compatible with Arial);
+// scripts without a table use an average advance per character
+type fontMetrics struct {
+	regular []int // Widths of ASCII 32..126
+	bold    []int
+	mono    int // Fixed advance for monospace fonts (0 = proportional)
+}
+
+// helveticaRegular holds the AFM widths of Helvetica for ASCII 32..126
+var helveticaRegular = []int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278, // space - /
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, // 0 - 9
+	278, 278, 584, 584, 584, 556, 1015, // : - @
+	667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, // A - M
+	722, 778, 667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, // N - Z
+	278, 278, 278, 469, 556, 333, // [ - `
+	556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, // a - m
+	556, 556, 556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, // n - z
+	334, 260, 334, 584, // { - ~
+}
+
+// helveticaBold holds the AFM widths of Helvetica-Bold for ASCII 32..126
+var helveticaBold = []int{
+	278, 333, 474, 556, 556, 889, 722, 238, 333, 333, 389, 584, 278, 333, 278, 278, // space - /
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, // 0 - 9
+	333, 333, 584, 584, 584, 611, 975, // : - @
+	722, 722, 722, 722, 667, 611, 778, 722, 278, 556, 722, 611, 833, // A - M
+	722, 778, 667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, // N - Z
+	333, 278, 333, 584, 556, 333, // [ - `
+	556, 611, 556, 611, 556, 333, 611, 611, 278, 278, 556, 278, 889, // a - m
+	611, 611, 611, 611, 389, 556, 333, 611, 556, 778, 556, 556, 500, // n - z
+	389, 280, 389, 584, // { - ~
+}
+
+var (
+	proportionalMetrics = &fontMetrics{regular: helveticaRegular, bold: helveticaBold}
+	monospaceMetrics    = &fontMetrics{mono: 600}
+)
+
+// metricsForFont picks metrics by font family name (Courier-like fonts are monospace)
+func metricsForFont(family string) *fontMetrics {
+	name := strings.ToLower(family)
+	if strings.Contains(name, "courier") || strings.Contains(name, "mono") {
+		return monospaceMetrics
+	}
+	return proportionalMetrics
+}
+
+// width returns the advance width of s in user units for a font size
+func (m *fontMetrics) width(s string, fontSize float64, bold bool) float64 {
+	total := 0
+	for _, r := range s {
+		total += m.advance(r, bold)
+	}
+	return float64(total) * fontSize / 1000
+}
+
+// advance returns the advance of one character in 1/1000 em
+func (m *fontMetrics) advance(r rune, bold bool) int {
+	// Thai vowels and tone marks above/below the consonant take no space
+	if isThaiCombining(r) || unicode.Is(unicode.Mn, r) {
+		return 0
+	}
+	if m.mono > 0 {
+		if unicode.Is(unicode.Han, r) || unicode.Is(unicode.Hangul, r) {
+			return 2 * m.mono
+		}
+		return m.mono
+	}
+
+	table := m.regular
+	if bold {
+		table = m.bold
+	}
+	switch {
+	case r >= 32 && r <= 126:
+		return table[r-32]
+	case unicode.Is(unicode.Thai, r):
+		if bold {
+			return 640
+		}
+		return 600
+	case unicode.Is(unicode.Han, r) || unicode.Is(unicode.Hangul, r) || unicode.Is(unicode.Hiragana, r) || unicode.Is(unicode.Katakana, r):
+		return 1000
+	case r == '×' || r == '≈' || r == '±':
+		return 584
+	default:
+		return 556
+	}
+}
+
+// isThaiCombining reports whether r is a Thai mark drawn above or below the previous character
+func isThaiCombining(r rune) bool {
+	return r == 0x0E31 || (r >= 0x0E34 && r <= 0x0E3A) || (r >= 0x0E47 && r <= 0x0E4E)
+}
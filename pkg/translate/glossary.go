@@ -0,0 +1,233 @@
+package translate
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/itchyny/gojq"
+)
+
+// GlossaryTerm is a fixed translation for a project term
+type GlossaryTerm struct {
+	Source string `json:"source"`
+	Target string `json:"target"`           // Empty until someone translates a seeded term
+	Note   string `json:"note,omitempty"`   // Definition shown to translators
+	Origin string `json:"origin,omitempty"` // "designVocabulary", "element", "vocabulary" or "manual"
+}
+
+// Glossary holds the project terminology for one target language
+// Stored in: {rootDir}/{target.glossary} (default code/glossary.{language}.json)
+type Glossary struct {
+	filePath       string
+	SourceLanguage string         `json:"source_language"`
+	TargetLanguage string         `json:"target_language"`
+	Terms          []GlossaryTerm `json:"terms"`
+}
+
+// GlossaryViolation is an applied translation whose source uses a glossary
+// term but whose translation does not use the term's translation
+type GlossaryViolation struct {
+	Term       GlossaryTerm
+	SourceText string
+	TargetText string
+}
+
+// LoadGlossary loads the glossary of a target language (an empty glossary if none exists yet)
+// Single entry point for glossary loading
+func LoadGlossary(rootDir string, config *Config, target TargetConfig) (*Glossary, error) {
+	glossary := &Glossary{
+		filePath:       filepath.Join(rootDir, target.Glossary),
+		SourceLanguage: config.Source.Language,
+		TargetLanguage: target.Language,
+	}
+
+	data, err := os.ReadFile(glossary.filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return glossary, nil // No glossary yet
+		}
+		return nil, fmt.Errorf("failed to read glossary: %w", err)
+	}
+
+	if err := json.Unmarshal(data, glossary); err != nil {
+		return nil, fmt.Errorf("failed to parse glossary %s: %w", target.Glossary, err)
+	}
+
+	return glossary, nil
+}
+
+// Save writes the glossary back to disk, sorted by source term
+func (g *Glossary) Save() error {
+	sort.SliceStable(g.Terms, func(i, j int) bool {
+		return strings.ToLower(g.Terms[i].Source) < strings.ToLower(g.Terms[j].Source)
+	})
+
+	jsonData, err := json.MarshalIndent(g, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal glossary: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(g.filePath), 0755); err != nil {
+		return fmt.Errorf("failed to create glossary directory: %w", err)
+	}
+
+	if err := os.WriteFile(g.filePath, append(jsonData, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write glossary: %w", err)
+	}
+
+	return nil
+}
+
+// Add records a term translation, replacing the translation of an existing term
+// (terms match case-insensitively). An empty note keeps the existing note
+func (g *Glossary) Add(source, target, note, origin string) {
+	for i := range g.Terms {
+		if strings.EqualFold(g.Terms[i].Source, source) {
+			g.Terms[i].Target = target
+			if note != "" {
+				g.Terms[i].Note = note
+			}
+			return
+		}
+	}
+	g.Terms = append(g.Terms, GlossaryTerm{Source: source, Target: target, Note: note, Origin: origin})
+}
+
+// Terminology returns the translated terms as source → target (for TranslationRequest.Terminology)
+func (g *Glossary) Terminology() map[string]string {
+	terminology := make(map[string]string)
+	for _, term := range g.Terms {
+		if term.Target != "" {
+			terminology[term.Source] = term.Target
+		}
+	}
+	return terminology
+}
+
+// SeedGlossary adds the terms of drawing-standards.json that are not in the glossary yet:
+// designVocabulary.terms, element names and each element's vocabulary phrases
+// Seeded terms have no translation; existing terms are never changed
+// Returns the number of terms added (0 if the standards file does not exist)
+func SeedGlossary(glossary *Glossary, standardsPath string) (int, error) {
+	data, err := os.ReadFile(standardsPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil // No standards, nothing to seed
+		}
+		return 0, fmt.Errorf("failed to read drawing standards: %w", err)
+	}
+
+	var standards interface{}
+	if err := json.Unmarshal(data, &standards); err != nil {
+		return 0, fmt.Errorf("failed to parse drawing standards: %w", err)
+	}
+
+	known := make(map[string]bool)
+	for _, term := range glossary.Terms {
+		known[strings.ToLower(term.Source)] = true
+	}
+
+	added := 0
+	seed := func(source, note, origin string) {
+		source = strings.TrimSpace(source)
+		if source == "" || known[strings.ToLower(source)] {
+			return
+		}
+		known[strings.ToLower(source)] = true
+		glossary.Terms = append(glossary.Terms, GlossaryTerm{Source: source, Note: note, Origin: origin})
+		added++
+	}
+
+	// Design vocabulary: camelCase keys become words (loadPath -> load path)
+	err = runStandardsQuery(standards, `.drawingStandards.designVocabulary.terms // {} | to_entries[] | [.key, .value]`, func(v []interface{}) {
+		key, _ := v[0].(string)
+		note, _ := v[1].(string)
+		seed(splitCamelCase(key), note, "designVocabulary")
+	})
+	if err != nil {
+		return added, err
+	}
+
+	// Elements: the element name and every vocabulary phrase
+	err = runStandardsQuery(standards, `.drawingStandards.elements // {} | to_entries[] | [.key, (.value.description // ""), (.value.vocabulary // "")]`, func(v []interface{}) {
+		name, _ := v[0].(string)
+		description, _ := v[1].(string)
+		vocabulary, _ := v[2].(string)
+		seed(name, description, "element")
+		for _, phrase := range strings.Split(vocabulary, ",") {
+			seed(strings.ToLower(phrase), "Vocabulary of "+name, "vocabulary")
+		}
+	})
+
+	return added, err
+}
+
+// CheckGlossary flags applied translations (from the translation memory) whose
+// source text contains a translated glossary term while the translation does not
+// contain the term's translation
+func CheckGlossary(glossary *Glossary, memory *Memory) []GlossaryViolation {
+	var violations []GlossaryViolation
+	entries := memory.Entries(glossary.SourceLanguage, glossary.TargetLanguage)
+
+	for _, term := range glossary.Terms {
+		if term.Target == "" {
+			continue
+		}
+		pattern := regexp.MustCompile(`(?i)\b` + regexp.QuoteMeta(term.Source) + `\b`)
+		for _, entry := range entries {
+			if !pattern.MatchString(entry.SourceText) {
+				continue
+			}
+			if strings.Contains(strings.ToLower(entry.TargetText), strings.ToLower(term.Target)) {
+				continue
+			}
+			violations = append(violations, GlossaryViolation{
+				Term:       term,
+				SourceText: entry.SourceText,
+				TargetText: entry.TargetText,
+			})
+		}
+	}
+
+	return violations
+}
+
+// runStandardsQuery runs a jq query over drawing-standards.json and calls fn with every array result
+func runStandardsQuery(standards interface{}, expr string, fn func([]interface{})) error {
+	query, err := gojq.Parse(expr)
+	if err != nil {
+		return fmt.Errorf("error parsing query: %w", err)
+	}
+
+	iter := query.Run(standards)
+	for {
+		v, ok := iter.Next()
+		if !ok {
+			return nil
+		}
+		if err, ok := v.(error); ok {
+			return fmt.Errorf("query error: %w", err)
+		}
+		if values, ok := v.([]interface{}); ok {
+			fn(values)
+		}
+	}
+}
+
+// splitCamelCase turns a camelCase key into lower-case words (loadPath -> load path)
+func splitCamelCase(s string) string {
+	var sb strings.Builder
+	for i, r := range s {
+		if unicode.IsUpper(r) && i > 0 {
+			sb.WriteByte(' ')
+		}
+		sb.WriteRune(unicode.ToLower(r))
+	}
+	return sb.String()
+}
@@ -0,0 +1,271 @@
+package translate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/itchyny/gojq"
+)
+
+// JSON documents (e.g. code/drawings.json) live outside the source folder.
+// translate.json lists each document with jq paths to its translatable strings;
+// sync copies it to a per-language variant which apply then translates in place
+
+// jsonIdentifier matches object keys that can be written as .key in a jq path
+var jsonIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// TargetPath returns the path of the per-language variant of a JSON document
+func (d JSONDocument) TargetPath(language string) string {
+	if d.Target != "" {
+		return strings.ReplaceAll(d.Target, "{language}", language)
+	}
+	ext := path.Ext(d.Source)
+	return strings.TrimSuffix(d.Source, ext) + "." + language + ext
+}
+
+// ScanJSONDocuments plans copying each configured JSON document to its
+// per-language variant for a target
+func ScanJSONDocuments(rootDir string, docs []JSONDocument, target TargetConfig) ([]SyncAction, error) {
+	var actions []SyncAction
+	for _, doc := range docs {
+		sourcePath := filepath.Join(rootDir, doc.Source)
+		if _, err := os.Stat(sourcePath); err != nil {
+			return nil, fmt.Errorf("JSON document %s: %w", doc.Source, err)
+		}
+		actions = append(actions, SyncAction{
+			Action: "copy",
+			Source: sourcePath,
+			Target: filepath.Join(rootDir, doc.TargetPath(target.Language)),
+			Type:   "json",
+		})
+	}
+	return actions, nil
+}
+
+// findJSONDocument returns the configured document whose source, or whose
+// variant for language, is filePath (relative to the root or absolute)
+func findJSONDocument(config *Config, filePath string, language string) (*JSONDocument, bool) {
+	slashPath := filepath.ToSlash(filePath)
+	for i, doc := range config.JSONDocuments {
+		for _, candidate := range []string{doc.Source, doc.TargetPath(language)} {
+			candidate = path.Clean(candidate)
+			if slashPath == candidate || strings.HasSuffix(slashPath, "/"+candidate) {
+				return &config.JSONDocuments[i], true
+			}
+		}
+	}
+	return nil, false
+}
+
+// extractJSONText extracts the strings selected by the document's jq paths
+func extractJSONText(filePath string, doc *JSONDocument) ([]TextExtraction, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes, err := scanJSONTextNodes(data, doc.Paths)
+	if err != nil {
+		return nil, err
+	}
+
+	var extractions []TextExtraction
+	for _, node := range nodes {
+		extractions = append(extractions, TextExtraction{
+			ID:         extractionID(node.Path, node.Text),
+			Line:       node.Line,
+			XPath:      node.Path,
+			Context:    node.Context,
+			SourceText: node.Text,
+			TargetText: "",
+		})
+	}
+
+	return extractions, nil
+}
+
+// applyJSONTranslations applies translations to a per-language JSON document
+// Only the selected string literals are replaced; key order and formatting are kept
+func applyJSONTranslations(filePath string, extractions []TextExtraction, exprs []string) (int, []UnresolvedExtraction, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	nodes, err := scanJSONTextNodes(data, exprs)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+
+	output, applied, unresolved := applyToNodes(data, nodes, extractions, renderJSONText)
+
+	// Write back to file
+	return applied, unresolved, os.WriteFile(filePath, output, 0644)
+}
+
+// scanJSONTextNodes returns a text node for every non-empty string selected by the jq paths
+// Node paths are concrete jq paths (e.g. .drawings.files[2].title) and the
+// context is the object key holding the string
+func scanJSONTextNodes(data []byte, exprs []string) ([]textNode, error) {
+	var document interface{}
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+
+	selected, err := selectJSONPaths(document, exprs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Walk the token stream to find the byte range of every string value
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	type frame struct {
+		path      string
+		array     bool
+		index     int
+		key       string
+		expectKey bool
+	}
+	var stack []*frame
+
+	valuePath := func() (string, string) {
+		if len(stack) == 0 {
+			return "", ""
+		}
+		top := stack[len(stack)-1]
+		if top.array {
+			return top.path + jsonPathSegment(top.index), ""
+		}
+		return top.path + jsonPathSegment(top.key), top.key
+	}
+	next := func() {
+		if len(stack) == 0 {
+			return
+		}
+		top := stack[len(stack)-1]
+		if top.array {
+			top.index++
+		} else {
+			top.expectKey = true
+		}
+	}
+
+	var nodes []textNode
+	for {
+		start := int(decoder.InputOffset())
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		end := int(decoder.InputOffset())
+
+		switch value := token.(type) {
+		case json.Delim:
+			switch value {
+			case '{', '[':
+				path, _ := valuePath()
+				stack = append(stack, &frame{path: path, array: value == '[', expectKey: value == '{'})
+			case '}', ']':
+				stack = stack[:len(stack)-1]
+				next()
+			}
+
+		case string:
+			if len(stack) > 0 && stack[len(stack)-1].expectKey {
+				stack[len(stack)-1].key = value
+				stack[len(stack)-1].expectKey = false
+				continue
+			}
+
+			path, key := valuePath()
+			if selected[path] && strings.TrimSpace(value) != "" {
+				// The raw token may start with the ':' or ',' that precedes it
+				literalStart := start + bytes.IndexByte(data[start:end], '"')
+				nodes = append(nodes, textNode{
+					Path:    path,
+					Context: key,
+					Text:    value,
+					Line:    bytes.Count(data[:literalStart], []byte("\n")) + 1,
+					Spans:   []textSpan{{Start: literalStart, End: end}},
+				})
+			}
+			next()
+
+		default:
+			next()
+		}
+	}
+
+	return nodes, nil
+}
+
+// selectJSONPaths runs path(expr) for every jq expression and returns the
+// concrete paths it selects
+func selectJSONPaths(document interface{}, exprs []string) (map[string]bool, error) {
+	selected := make(map[string]bool)
+	for _, expr := range exprs {
+		query, err := gojq.Parse("path(" + expr + ")")
+		if err != nil {
+			return nil, fmt.Errorf("invalid jq path %q: %w", expr, err)
+		}
+
+		iter := query.Run(document)
+		for {
+			v, ok := iter.Next()
+			if !ok {
+				break
+			}
+			if err, ok := v.(error); ok {
+				return nil, fmt.Errorf("jq path %q: %w", expr, err)
+			}
+
+			var sb strings.Builder
+			for _, segment := range v.([]interface{}) {
+				sb.WriteString(jsonPathSegment(segment))
+			}
+			selected[sb.String()] = true
+		}
+	}
+	return selected, nil
+}
+
+// jsonPathSegment formats one jq path segment: .key, ["odd key"] or [index]
+func jsonPathSegment(segment interface{}) string {
+	switch s := segment.(type) {
+	case string:
+		if jsonIdentifier.MatchString(s) {
+			return "." + s
+		}
+		return "[" + strconv.Quote(s) + "]"
+	case int:
+		return "[" + strconv.Itoa(s) + "]"
+	case float64:
+		return "[" + strconv.Itoa(int(s)) + "]"
+	default:
+		return fmt.Sprintf("[%v]", s)
+	}
+}
+
+// renderJSONText encodes a translation as a JSON string literal
+func renderJSONText(node textNode, translation string) ([]string, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(translation); err != nil {
+		return nil, err
+	}
+	return []string{strings.TrimSuffix(buf.String(), "\n")}, nil
+}
@@ -0,0 +1,238 @@
+package translate
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// thaiWordlist is the dictionary for Thai word segmentation (one word per line)
+//
+//go:embed thaiwords.txt
+var thaiWordlist string
+
+var (
+	thaiDictionaryOnce sync.Once
+	thaiDictionary     map[string]bool
+	thaiMaxWordLen     int // Longest dictionary word in runes
+)
+
+// loadThaiDictionary parses the embedded wordlist once
+func loadThaiDictionary() {
+	thaiDictionaryOnce.Do(func() {
+		thaiDictionary = make(map[string]bool)
+		for _, line := range strings.Split(thaiWordlist, "\n") {
+			word := strings.TrimSpace(line)
+			if word == "" || strings.HasPrefix(word, "#") {
+				continue
+			}
+			thaiDictionary[word] = true
+			if n := utf8.RuneCountInString(word); n > thaiMaxWordLen {
+				thaiMaxWordLen = n
+			}
+		}
+	})
+}
+
+// segmentThai splits a run of Thai text into words
+// Dictionary maximal matching: of all splits into dictionary words, the one with
+// the fewest characters outside the dictionary, then the fewest words, wins.
+// Unknown text is cut only at Thai character cluster boundaries, so vowels and
+// tone marks are never separated from their consonant
+func segmentThai(text string) []string {
+	loadThaiDictionary()
+	runes := []rune(text)
+	n := len(runes)
+	if n == 0 {
+		return nil
+	}
+
+	// Positions where a word may start (cluster boundaries)
+	boundary := make([]bool, n+1)
+	boundary[0], boundary[n] = true, true
+	for i := 1; i < n; i++ {
+		boundary[i] = !thaiNoBreakBefore(runes[i]) && !thaiNoBreakAfter(runes[i-1])
+	}
+
+	type step struct {
+		unknown, words int
+		prev           int
+		known          bool // runes[prev:i] is a dictionary word
+		reached        bool
+	}
+	best := make([]step, n+1)
+	best[0].reached = true
+	better := func(a, b step) bool {
+		return !b.reached || a.unknown < b.unknown || (a.unknown == b.unknown && a.words < b.words)
+	}
+
+	for i := 0; i < n; i++ {
+		if !best[i].reached || !boundary[i] {
+			continue
+		}
+		// Dictionary words starting at i
+		for j := i + 1; j <= n && j-i <= thaiMaxWordLen; j++ {
+			if !boundary[j] || !thaiDictionary[string(runes[i:j])] {
+				continue
+			}
+			candidate := step{unknown: best[i].unknown, words: best[i].words + 1, prev: i, known: true, reached: true}
+			if better(candidate, best[j]) {
+				best[j] = candidate
+			}
+		}
+		// One unknown cluster
+		j := i + 1
+		for !boundary[j] {
+			j++
+		}
+		candidate := step{unknown: best[i].unknown + j - i, words: best[i].words + 1, prev: i, reached: true}
+		if better(candidate, best[j]) {
+			best[j] = candidate
+		}
+	}
+
+	// Walk back, merging neighbouring unknown clusters into one token
+	var words []string
+	unknownEnd := -1
+	for i := n; i > 0; i = best[i].prev {
+		s := best[i]
+		if s.known {
+			if unknownEnd != -1 {
+				words = append(words, string(runes[i:unknownEnd]))
+				unknownEnd = -1
+			}
+			words = append(words, string(runes[s.prev:i]))
+			continue
+		}
+		if unknownEnd == -1 {
+			unknownEnd = i
+		}
+		if s.prev == 0 || best[s.prev].known {
+			words = append(words, string(runes[s.prev:unknownEnd]))
+			unknownEnd = -1
+		}
+	}
+
+	for i, j := 0, len(words)-1; i < j; i, j = i+1, j-1 {
+		words[i], words[j] = words[j], words[i]
+	}
+	return words
+}
+
+// thaiNoBreakBefore reports whether a character belongs to the cluster before it
+// (marks above/below, following vowels ะ า ำ ๅ, ฯ and ๆ)
+func thaiNoBreakBefore(r rune) bool {
+	return isThaiCombining(r) || r == 'ะ' || r == 'า' || r == 'ำ' || r == 'ๅ' || r == 'ฯ' || r == 'ๆ'
+}
+
+// thaiNoBreakAfter reports whether a character belongs to the cluster after it
+// (leading vowels เ แ โ ใ ไ)
+func thaiNoBreakAfter(r rune) bool {
+	return r >= 'เ' && r <= 'ไ'
+}
+
+// breakTokens splits text into line-break units that concatenate back to the text:
+// words keep their trailing spaces, and Thai runs are split into words
+func breakTokens(text string) []string {
+	var tokens []string
+	for len(text) > 0 {
+		// Next word (up to a space) and the spaces after it
+		end := strings.IndexFunc(text, unicode.IsSpace)
+		if end == -1 {
+			end = len(text)
+		}
+		word := text[:end]
+		spaces := len(text[end:]) - len(strings.TrimLeftFunc(text[end:], unicode.IsSpace))
+		text = text[end+spaces:]
+
+		parts := splitThaiRuns(word)
+		if len(parts) == 0 {
+			if len(tokens) > 0 {
+				tokens[len(tokens)-1] += strings.Repeat(" ", spaces) // Leading spaces
+			}
+			continue
+		}
+		parts[len(parts)-1] += strings.Repeat(" ", spaces)
+		tokens = append(tokens, parts...)
+	}
+	return tokens
+}
+
+// splitThaiRuns splits a word without spaces into its Thai words and the non-Thai text between them
+func splitThaiRuns(word string) []string {
+	var parts []string
+	start := 0
+	thai := false
+	flush := func(end int) {
+		if end <= start {
+			return
+		}
+		if thai {
+			parts = append(parts, segmentThai(word[start:end])...)
+		} else {
+			parts = append(parts, word[start:end])
+		}
+		start = end
+	}
+	for i, r := range word {
+		isThai := unicode.Is(unicode.Thai, r) && !(r >= '๐' && r <= '๙')
+		if i > 0 && isThai != thai {
+			flush(i)
+		}
+		thai = isThai
+	}
+	flush(len(word))
+	return parts
+}
+
+// wrapText breaks text into lines no wider than maxWidth; Thai is broken between
+// words. A single word wider than maxWidth gets a line of its own
+func wrapText(text string, maxWidth float64, measure func(string) float64) []string {
+	var rows []string
+	current := ""
+	for _, token := range breakTokens(text) {
+		candidate := current + token
+		if current != "" && measure(strings.TrimSpace(candidate)) > maxWidth {
+			rows = append(rows, strings.TrimSpace(current))
+			current = token
+			continue
+		}
+		current = candidate
+	}
+	if strings.TrimSpace(current) != "" {
+		rows = append(rows, strings.TrimSpace(current))
+	}
+	return rows
+}
+
+// renderTspans writes wrapped lines as <tspan> elements centred on the <text> baseline
+// lineHeight is in em (typography.lineHeight)
+func renderTspans(rows []string, x float64, lineHeight float64) (string, error) {
+	var sb strings.Builder
+	for i, row := range rows {
+		dy := lineHeight
+		if i == 0 {
+			dy = -float64(len(rows)-1) * lineHeight / 2
+		}
+		var escaped bytes.Buffer
+		if err := xml.EscapeText(&escaped, []byte(row)); err != nil {
+			return "", err
+		}
+		if dy == 0 {
+			fmt.Fprintf(&sb, `<tspan x="%s">%s</tspan>`, formatNumber(x), escaped.String())
+		} else {
+			fmt.Fprintf(&sb, `<tspan x="%s" dy="%sem">%s</tspan>`, formatNumber(x), formatEm(dy), escaped.String())
+		}
+	}
+	return sb.String(), nil
+}
+
+// formatEm prints an em offset with up to two decimals
+func formatEm(v float64) string {
+	return strings.TrimRight(strings.TrimRight(fmt.Sprintf("%.2f", v), "0"), ".")
+}
@@ -0,0 +1,291 @@
+package translate
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	extast "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/text"
+)
+
+// markdownParser parses GitHub-flavoured Markdown (tables enabled)
+var markdownParser = goldmark.New(goldmark.WithExtensions(extension.Table)).Parser()
+
+// scanMarkdownTextNodes parses a Markdown document and returns one text node per
+// paragraph, heading, list item and table cell
+// Paths count units per context (e.g. /md/heading[2]) so that they survive
+// edits elsewhere in the file. Front matter, code blocks and HTML are skipped;
+// link targets, image sources and inline code become ⟦n⟧ placeholders
+func scanMarkdownTextNodes(data []byte) ([]textNode, error) {
+	source := blankFrontMatter(data)
+	doc := markdownParser.Parse(text.NewReader(source))
+
+	var nodes []textNode
+	contextCount := map[string]int{}
+
+	err := ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		var context string
+		switch n.(type) {
+		case *ast.Heading:
+			context = "heading"
+		case *ast.Paragraph, *ast.TextBlock:
+			context = "paragraph"
+			switch n.Parent().(type) {
+			case *ast.ListItem:
+				context = "list-item"
+			case *ast.Blockquote:
+				context = "blockquote"
+			}
+		case *extast.TableCell:
+			context = "table-cell"
+			if _, ok := n.Parent().(*extast.TableHeader); ok {
+				context = "table-header"
+			}
+		case *ast.FencedCodeBlock, *ast.CodeBlock, *ast.HTMLBlock:
+			return ast.WalkSkipChildren, nil
+		default:
+			return ast.WalkContinue, nil
+		}
+
+		lines := n.Lines()
+		if lines.Len() == 0 {
+			return ast.WalkSkipChildren, nil
+		}
+
+		// Line breaks inside a unit are kept; a hard break (two trailing spaces)
+		// keeps its spaces, a backslash break its backslash
+		var parts []string
+		indent := ""
+		for i := 0; i < lines.Len(); i++ {
+			segment := lines.At(i)
+			line := strings.TrimRight(string(segment.Value(source)), "\r\n")
+			part := strings.TrimSpace(line)
+			if part == "" {
+				continue
+			}
+			if strings.HasSuffix(line, "  ") {
+				part += "  "
+			}
+			if len(parts) == 1 {
+				indent = linePrefix(source, segment.Start)
+			}
+			parts = append(parts, part)
+		}
+		if len(parts) == 0 {
+			return ast.WalkSkipChildren, nil
+		}
+		text := strings.TrimRight(strings.Join(parts, "\n"), " ")
+
+		// Replace range: first to last non-blank byte of the unit
+		start := lines.At(0).Start
+		for start < len(source) && (source[start] == ' ' || source[start] == '\t') {
+			start++
+		}
+		end := lines.At(lines.Len() - 1).Stop
+		for end > start && strings.ContainsRune(" \t\r\n", rune(source[end-1])) {
+			end--
+		}
+		protected, placeholders := protectMarkdownInline(text)
+
+		contextCount[context]++
+		nodes = append(nodes, textNode{
+			Path:         fmt.Sprintf("/md/%s[%d]", context, contextCount[context]),
+			Context:      context,
+			Text:         protected,
+			Line:         bytes.Count(source[:start], []byte("\n")) + 1,
+			Spans:        []textSpan{{Start: start, End: end}},
+			Placeholders: placeholders,
+			Indent:       indent,
+		})
+
+		// Nested lists inside a list item are their own units
+		return ast.WalkSkipChildren, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return nodes, nil
+}
+
+// linePrefix returns what precedes the text starting at offset on its line
+// (indentation and > markers that continuation lines need to stay in their block)
+func linePrefix(source []byte, offset int) string {
+	lineStart := bytes.LastIndexByte(source[:offset], '\n') + 1
+	end := offset
+	for end < len(source) && (source[end] == ' ' || source[end] == '\t') {
+		end++
+	}
+	return string(source[lineStart:end])
+}
+
+// blankFrontMatter replaces YAML (---) or TOML (+++) front matter with spaces,
+// keeping byte offsets and line numbers intact
+func blankFrontMatter(data []byte) []byte {
+	for _, fence := range []string{"---", "+++"} {
+		if !bytes.HasPrefix(data, []byte(fence+"\n")) && !bytes.HasPrefix(data, []byte(fence+"\r\n")) {
+			continue
+		}
+		closing := bytes.Index(data[len(fence):], []byte("\n"+fence))
+		if closing == -1 {
+			return data
+		}
+		end := len(fence) + closing + 1 + len(fence)
+
+		blanked := append([]byte(nil), data...)
+		for i := 0; i < end; i++ {
+			if blanked[i] != '\n' && blanked[i] != '\r' {
+				blanked[i] = ' '
+			}
+		}
+		return blanked
+	}
+	return data
+}
+
+// protectMarkdownInline replaces spans the translator must not touch with ⟦n⟧ tokens:
+// inline code, link/image destinations "](...)", autolinks and inline HTML tags
+// Link text and image alt text stay translatable
+func protectMarkdownInline(s string) (string, map[string]string) {
+	var sb strings.Builder
+	placeholders := map[string]string{}
+
+	protect := func(span string) {
+		token := fmt.Sprintf("⟦%d⟧", len(placeholders)+1)
+		placeholders[token] = span
+		sb.WriteString(token)
+	}
+
+	for i := 0; i < len(s); {
+		switch {
+		case s[i] == '\\' && i+1 < len(s):
+			// Backslash escape - keep both characters literally
+			sb.WriteString(s[i : i+2])
+			i += 2
+			continue
+
+		case s[i] == '`':
+			// Code span: closed by a backtick run of the same length
+			run := 1
+			for i+run < len(s) && s[i+run] == '`' {
+				run++
+			}
+			fence := strings.Repeat("`", run)
+			if end := strings.Index(s[i+run:], fence); end >= 0 {
+				protect(s[i : i+run+end+run])
+				i += run + end + run
+				continue
+			}
+			sb.WriteString(fence)
+			i += run
+			continue
+
+		case strings.HasPrefix(s[i:], "]("):
+			// Link or image destination (with optional title), parentheses may nest
+			depth := 0
+			for j := i + 1; j < len(s); j++ {
+				if s[j] == '\\' {
+					j++
+					continue
+				}
+				if s[j] == '(' {
+					depth++
+				} else if s[j] == ')' {
+					depth--
+					if depth == 0 {
+						sb.WriteString("](")
+						protect(s[i+2 : j])
+						sb.WriteString(")")
+						i = j + 1
+						break
+					}
+				}
+			}
+			if depth == 0 {
+				continue
+			}
+
+		case s[i] == '<':
+			// Autolink (<https://...>) or inline HTML tag (<br>, </span>)
+			if end := strings.IndexByte(s[i:], '>'); end > 1 {
+				tag := s[i : i+end+1]
+				if !strings.ContainsAny(tag, " \t") || strings.Contains(tag, "=") {
+					if c := tag[1]; c == '/' || c == '!' || (c|0x20 >= 'a' && c|0x20 <= 'z') {
+						protect(tag)
+						i += end + 1
+						continue
+					}
+				}
+			}
+		}
+
+		sb.WriteByte(s[i])
+		i++
+	}
+
+	if len(placeholders) == 0 {
+		return s, nil
+	}
+	return sb.String(), placeholders
+}
+
+// renderMarkdownText turns a translation back into Markdown for a unit:
+// restores ⟦n⟧ placeholders from the target node, keeps its line breaks
+// (collapsing whitespace within each line, indenting continuation lines like the
+// source) and escapes pipes inside table cells, which stay on one line
+func renderMarkdownText(node textNode, translation string) ([]string, error) {
+	tokens := make([]string, 0, len(node.Placeholders))
+	for token := range node.Placeholders {
+		tokens = append(tokens, token)
+	}
+	sort.Strings(tokens)
+
+	rendered := translation
+	for _, token := range tokens {
+		if !strings.Contains(rendered, token) {
+			return nil, fmt.Errorf("translation is missing placeholder %s (%s)", token, node.Placeholders[token])
+		}
+		rendered = strings.ReplaceAll(rendered, token, node.Placeholders[token])
+	}
+
+	table := node.Context == "table-cell" || node.Context == "table-header"
+	var lines []string
+	for _, line := range strings.Split(rendered, "\n") {
+		hardBreak := strings.HasSuffix(strings.TrimRight(line, "\r"), "  ")
+		line = strings.Join(strings.Fields(line), " ")
+		if line == "" {
+			continue // A blank line would end the paragraph
+		}
+		if hardBreak && !table {
+			line += "  "
+		}
+		lines = append(lines, line)
+	}
+	separator := "\n" + node.Indent
+	if table {
+		separator = " "
+	}
+	rendered = strings.TrimRight(strings.Join(lines, separator), " ")
+
+	if table {
+		var sb strings.Builder
+		for i := 0; i < len(rendered); i++ {
+			if rendered[i] == '|' && (i == 0 || rendered[i-1] != '\\') {
+				sb.WriteByte('\\')
+			}
+			sb.WriteByte(rendered[i])
+		}
+		rendered = sb.String()
+	}
+
+	return []string{rendered}, nil
+}
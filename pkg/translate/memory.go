@@ -0,0 +1,221 @@
+package translate
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// fuzzyThreshold is the minimum similarity (0-1) for a fuzzy memory match
+const fuzzyThreshold = 0.75
+
+// MemoryEntry is a single remembered translation
+type MemoryEntry struct {
+	SourceLanguage string    `json:"source_language"`
+	TargetLanguage string    `json:"target_language"`
+	SourceText     string    `json:"source_text"`
+	TargetText     string    `json:"target_text"`
+	Updated        time.Time `json:"updated"`
+	// Review status of the applied translation, restored on exact matches
+	Status     string     `json:"status,omitempty"`
+	Reviewer   string     `json:"reviewer,omitempty"`
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+}
+
+// MemoryMatch is the result of a memory lookup
+type MemoryMatch struct {
+	Entry MemoryEntry
+	Score float64 // 1.0 = exact match
+}
+
+// Memory is a persistent translation memory
+// Stored in: {rootDir}/{eventsPath}/memory.json, next to the event log
+type Memory struct {
+	filePath string
+	entries  map[string]MemoryEntry
+}
+
+// LoadMemory loads the translation memory (an empty memory if none exists yet)
+// Single entry point for translation memory loading
+func LoadMemory(rootDir string, eventsPath string) (*Memory, error) {
+	memory := &Memory{
+		filePath: filepath.Join(rootDir, eventsPath, "memory.json"),
+		entries:  make(map[string]MemoryEntry),
+	}
+
+	data, err := os.ReadFile(memory.filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return memory, nil // No memory yet
+		}
+		return nil, fmt.Errorf("failed to read translation memory: %w", err)
+	}
+
+	var stored struct {
+		Entries []MemoryEntry `json:"entries"`
+	}
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, fmt.Errorf("failed to parse translation memory: %w", err)
+	}
+
+	for _, entry := range stored.Entries {
+		memory.entries[memoryKey(entry.SourceLanguage, entry.TargetLanguage, entry.SourceText)] = entry
+	}
+
+	return memory, nil
+}
+
+// Save writes the translation memory back to disk
+func (m *Memory) Save() error {
+	entries := make([]MemoryEntry, 0, len(m.entries))
+	for _, entry := range m.entries {
+		entries = append(entries, entry)
+	}
+
+	// Stable order keeps the file diffable
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].TargetLanguage != entries[j].TargetLanguage {
+			return entries[i].TargetLanguage < entries[j].TargetLanguage
+		}
+		return entries[i].SourceText < entries[j].SourceText
+	})
+
+	jsonData, err := json.MarshalIndent(struct {
+		Entries []MemoryEntry `json:"entries"`
+	}{entries}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal translation memory: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(m.filePath), 0755); err != nil {
+		return fmt.Errorf("failed to create memory directory: %w", err)
+	}
+
+	if err := os.WriteFile(m.filePath, jsonData, 0644); err != nil {
+		return fmt.Errorf("failed to write translation memory: %w", err)
+	}
+
+	return nil
+}
+
+// Len returns the number of remembered translations
+func (m *Memory) Len() int {
+	return len(m.entries)
+}
+
+// Add records the translation of an extraction with its review status,
+// replacing any earlier translation of the same source text
+func (m *Memory) Add(sourceLang, targetLang string, ext TextExtraction) {
+	if ext.SourceText == "" || ext.TargetText == "" {
+		return
+	}
+	m.entries[memoryKey(sourceLang, targetLang, ext.SourceText)] = MemoryEntry{
+		SourceLanguage: sourceLang,
+		TargetLanguage: targetLang,
+		SourceText:     ext.SourceText,
+		TargetText:     ext.TargetText,
+		Updated:        time.Now(),
+		Status:         ext.Status,
+		Reviewer:       ext.Reviewer,
+		ReviewedAt:     ext.ReviewedAt,
+	}
+}
+
+// Lookup finds the best translation for a source text
+// Returns an exact match if there is one, otherwise the most similar entry
+// above the fuzzy threshold
+func (m *Memory) Lookup(sourceLang, targetLang, sourceText string) (*MemoryMatch, bool) {
+	if entry, ok := m.entries[memoryKey(sourceLang, targetLang, sourceText)]; ok {
+		return &MemoryMatch{Entry: entry, Score: 1.0}, true
+	}
+
+	var best *MemoryMatch
+	for _, entry := range m.entries {
+		if entry.SourceLanguage != sourceLang || entry.TargetLanguage != targetLang {
+			continue
+		}
+		score := similarity(sourceText, entry.SourceText)
+		if score < fuzzyThreshold {
+			continue
+		}
+		if best == nil || score > best.Score {
+			best = &MemoryMatch{Entry: entry, Score: score}
+		}
+	}
+
+	return best, best != nil
+}
+
+// Exact returns the remembered translation of exactly this source text (no fuzzy matching)
+func (m *Memory) Exact(sourceLang, targetLang, sourceText string) (MemoryEntry, bool) {
+	entry, ok := m.entries[memoryKey(sourceLang, targetLang, sourceText)]
+	return entry, ok
+}
+
+// Entries returns the remembered translations for a language pair, sorted by source text
+func (m *Memory) Entries(sourceLang, targetLang string) []MemoryEntry {
+	var entries []MemoryEntry
+	for _, entry := range m.entries {
+		if entry.SourceLanguage == sourceLang && entry.TargetLanguage == targetLang {
+			entries = append(entries, entry)
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].SourceText < entries[j].SourceText
+	})
+	return entries
+}
+
+// memoryKey builds the lookup key for a memory entry
+func memoryKey(sourceLang, targetLang, sourceText string) string {
+	return sourceLang + "\x00" + targetLang + "\x00" + sourceText
+}
+
+// similarity returns a normalized edit-distance similarity between two strings (0-1)
+func similarity(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	longest := len(ra)
+	if len(rb) > longest {
+		longest = len(rb)
+	}
+	if longest == 0 {
+		return 1.0
+	}
+
+	// Length difference alone bounds the best possible score
+	diff := len(ra) - len(rb)
+	if diff < 0 {
+		diff = -diff
+	}
+	if 1.0-float64(diff)/float64(longest) < fuzzyThreshold {
+		return 0
+	}
+
+	return 1.0 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+// levenshtein computes the edit distance between two rune slices
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
@@ -0,0 +1,346 @@
+package translate
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Hand edits to translated targets (a builder fixing wording in SPEC.th.md) must
+// survive the next sync. Every target sync or apply writes is snapshotted as its
+// base; a target that no longer matches its base was edited by hand, and sync
+// merges base, edited target and the new output line by line (like diff3)
+
+// EditedTarget is a target that was changed by hand since sync or apply last wrote it
+type EditedTarget struct {
+	Target string // Relative to the root
+	Type   string // "svg", "md", "json"
+	Base   []byte // What sync or apply last wrote
+	Edited []byte // The target as found before this sync
+}
+
+// MergeConflict is a region both the hand edit and the new output changed differently
+type MergeConflict struct {
+	Line   int      // First line of the region in the merged file
+	Base   []string // Lines before either change
+	Edited []string // Lines of the hand edit
+	Source []string // Lines of the new output
+}
+
+// MergeResult is the outcome of merging one edited target
+type MergeResult struct {
+	Target    string
+	Type      string
+	Conflicts []MergeConflict
+	Markers   bool // Conflicts are marked in the file (otherwise the new output was kept)
+}
+
+// BasePath returns where the base snapshot of a target is kept (relative to the root)
+func BasePath(config *Config, target string) string {
+	return filepath.Join(config.Paths.Events, "base", target)
+}
+
+// SaveBase snapshots a target as it is now as its base
+func SaveBase(rootDir string, config *Config, target string) error {
+	data, err := os.ReadFile(filepath.Join(rootDir, target))
+	if err != nil {
+		return err
+	}
+	return writeBase(rootDir, config, target, data)
+}
+
+// RemoveBase drops the base snapshot of a target that sync deleted
+func RemoveBase(rootDir string, config *Config, target string) error {
+	err := os.Remove(filepath.Join(rootDir, BasePath(config, target)))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// PlanMerge reads the translated targets that were edited by hand since their
+// base snapshot (QUERY - must run before ExecuteSync overwrites them)
+func PlanMerge(rootDir string, config *Config, actions []SyncAction) ([]EditedTarget, error) {
+	var edited []EditedTarget
+	for _, action := range actions {
+		if action.Action != "copy" || action.Type == "other" {
+			continue
+		}
+		target := relativePath(rootDir, action.Target)
+		base, err := os.ReadFile(filepath.Join(rootDir, BasePath(config, target)))
+		if err != nil {
+			continue // Never written by sync or apply since snapshots were kept
+		}
+		current, err := os.ReadFile(action.Target)
+		if err != nil || bytes.Equal(current, base) {
+			continue
+		}
+		edited = append(edited, EditedTarget{Target: target, Type: action.Type, Base: base, Edited: current})
+	}
+	return edited, nil
+}
+
+// MergeTargets merges the hand edits back into the targets sync just wrote and
+// makes what sync wrote the new base (COMMAND - run after ExecuteSync)
+// Markdown conflicts get <<<<<<< markers; SVG and JSON keep the new output so the
+// file stays valid, and their conflicts are only reported
+// Single entry point for protecting hand edits
+func MergeTargets(rootDir string, config *Config, edited []EditedTarget) ([]MergeResult, error) {
+	var results []MergeResult
+	for _, file := range edited {
+		targetPath := filepath.Join(rootDir, file.Target)
+		output, err := os.ReadFile(targetPath)
+		if err != nil {
+			return results, err
+		}
+
+		result := MergeResult{Target: file.Target, Type: file.Type, Markers: file.Type == "md"}
+		merged, conflicts := Merge3(file.Base, file.Edited, output, result.Markers)
+		result.Conflicts = conflicts
+
+		if err := os.WriteFile(targetPath, merged, 0644); err != nil {
+			return results, err
+		}
+		if err := writeBase(rootDir, config, file.Target, output); err != nil {
+			return results, err
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// ApplyToBases applies a task to the base snapshots too, so hand edits keep
+// showing as the difference between a target and its base; targets without a
+// snapshot are snapshotted as applied (run after ApplyTranslations)
+func ApplyToBases(rootDir string, task *Task, config *Config) error {
+	based := *task
+	based.Files = nil
+	for _, file := range task.Files {
+		if file.Type == "other" {
+			continue
+		}
+		basePath := BasePath(config, file.Target)
+		if _, err := os.Stat(filepath.Join(rootDir, basePath)); err != nil {
+			if err := SaveBase(rootDir, config, file.Target); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+			continue
+		}
+		file.Target = basePath
+		based.Files = append(based.Files, file)
+	}
+	if ValidateTask(&based).FilledExtractions == 0 {
+		return nil
+	}
+	_, err := ApplyTranslations(rootDir, &based, config)
+	return err
+}
+
+// WriteConflictReport writes the merge conflicts of a target language to
+// {tasks}/conflicts-{language}.md and returns its path ("" and no file when
+// there are none; a report left from an earlier sync is removed)
+func WriteConflictReport(rootDir string, config *Config, language string, results []MergeResult) (string, error) {
+	reportFile := filepath.Join(config.Paths.Tasks, fmt.Sprintf("conflicts-%s.md", language))
+	reportPath := filepath.Join(rootDir, reportFile)
+
+	var b strings.Builder
+	for _, result := range results {
+		for _, conflict := range result.Conflicts {
+			fmt.Fprintf(&b, "## %s:%d\n\n", result.Target, conflict.Line)
+			if result.Markers {
+				b.WriteString("Marked in the file with <<<<<<< / >>>>>>>; keep one side and delete the markers.\n\n")
+			} else {
+				b.WriteString("The file has the new version; copy the hand edit back if it still applies.\n\n")
+			}
+			writeConflictLines(&b, "Base", conflict.Base)
+			writeConflictLines(&b, "Hand edit", conflict.Edited)
+			writeConflictLines(&b, "New version", conflict.Source)
+		}
+	}
+	if b.Len() == 0 {
+		if err := os.Remove(reportPath); err != nil && !os.IsNotExist(err) {
+			return "", err
+		}
+		return "", nil
+	}
+
+	report := fmt.Sprintf("# Merge conflicts: %s\n\nHand edits that clash with changes from the source during translate sync.\n\n%s", language, b.String())
+	if err := os.MkdirAll(filepath.Dir(reportPath), 0755); err != nil {
+		return "", err
+	}
+	return reportFile, os.WriteFile(reportPath, []byte(report), 0644)
+}
+
+// conflictStart opens a conflict marked in a merged file
+const conflictStart = "<<<<<<< hand edit\n"
+
+// Merge3 merges the changes from base to edited and from base to source line by line
+// Where both changed the same region differently, markers keeps both sides between
+// conflict markers; otherwise the source side wins and the conflict is only returned
+// Markers are Markdown: each stands between blank lines, so ======= never becomes
+// the underline of a heading and no marker joins the paragraph next to it
+func Merge3(base, edited, source []byte, markers bool) ([]byte, []MergeConflict) {
+	baseLines, editedLines, sourceLines := splitLines(base), splitLines(edited), splitLines(source)
+	toEdited := matchLines(baseLines, editedLines)
+	toSource := matchLines(baseLines, sourceLines)
+
+	var merged []string
+	var conflicts []MergeConflict
+	i, e, s := 0, 0, 0
+	for {
+		// Next base line both sides kept (the end of all three files is the last one)
+		j := i
+		for j < len(baseLines) && (toEdited[j] < 0 || toSource[j] < 0) {
+			j++
+		}
+		je, js := len(editedLines), len(sourceLines)
+		if j < len(baseLines) {
+			je, js = toEdited[j], toSource[j]
+		}
+
+		baseChunk, editedChunk, sourceChunk := baseLines[i:j], editedLines[e:je], sourceLines[s:js]
+		switch {
+		case equalLines(editedChunk, baseChunk):
+			merged = append(merged, sourceChunk...)
+		case equalLines(sourceChunk, baseChunk), equalLines(editedChunk, sourceChunk):
+			merged = append(merged, editedChunk...)
+		default:
+			if markers {
+				merged = terminated(merged)
+				if len(merged) > 0 && strings.TrimSpace(merged[len(merged)-1]) != "" {
+					merged = append(merged, "\n")
+				}
+			}
+			conflicts = append(conflicts, MergeConflict{
+				Line:   len(merged) + 1,
+				Base:   baseChunk,
+				Edited: editedChunk,
+				Source: sourceChunk,
+			})
+			if markers {
+				merged = append(merged, conflictStart, "\n")
+				merged = append(merged, terminated(editedChunk)...)
+				merged = append(merged, "\n", "=======\n", "\n")
+				merged = append(merged, terminated(sourceChunk)...)
+				merged = append(merged, "\n", ">>>>>>> new version\n", "\n")
+			} else {
+				merged = append(merged, sourceChunk...)
+			}
+		}
+
+		if j == len(baseLines) {
+			break
+		}
+		merged = append(merged, baseLines[j])
+		i, e, s = j+1, je+1, js+1
+	}
+	return []byte(strings.Join(merged, "")), conflicts
+}
+
+// matchLines pairs the lines of a with lines of b along a longest common
+// subsequence; match[i] is the index in b of a[i], or -1
+func matchLines(a, b []string) []int {
+	match := make([]int, len(a))
+	for i := range match {
+		match[i] = -1
+	}
+
+	// Common prefix and suffix need no table (most edits touch a few lines)
+	start := 0
+	for start < len(a) && start < len(b) && a[start] == b[start] {
+		match[start] = start
+		start++
+	}
+	endA, endB := len(a), len(b)
+	for endA > start && endB > start && a[endA-1] == b[endB-1] {
+		endA--
+		endB--
+		match[endA] = endB
+	}
+
+	// Longest common subsequence of the middle, filled from the end
+	n, m := endA-start, endB-start
+	lengths := make([][]int32, n+1)
+	for i := range lengths {
+		lengths[i] = make([]int32, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if a[start+i] == b[start+j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else {
+				lengths[i][j] = max(lengths[i+1][j], lengths[i][j+1])
+			}
+		}
+	}
+	for i, j := 0, 0; i < n && j < m; {
+		switch {
+		case a[start+i] == b[start+j]:
+			match[start+i] = start + j
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+	return match
+}
+
+// hasConflictMarkers reports whether a merged file still has conflicts marked in it
+func hasConflictMarkers(data []byte) bool {
+	return bytes.HasPrefix(data, []byte(conflictStart)) || bytes.Contains(data, []byte("\n"+conflictStart))
+}
+
+// splitLines splits data into lines that keep their line endings
+func splitLines(data []byte) []string {
+	if len(data) == 0 {
+		return nil
+	}
+	lines := strings.SplitAfter(string(data), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// terminated returns lines with a line ending on the last one (before a marker)
+func terminated(lines []string) []string {
+	if len(lines) == 0 || strings.HasSuffix(lines[len(lines)-1], "\n") {
+		return lines
+	}
+	out := append([]string(nil), lines...)
+	out[len(out)-1] += "\n"
+	return out
+}
+
+// equalLines reports whether two line slices are the same
+func equalLines(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// writeConflictLines writes one side of a conflict as a fenced block
+func writeConflictLines(b *strings.Builder, label string, lines []string) {
+	fmt.Fprintf(b, "%s:\n\n```\n%s", label, strings.Join(terminated(lines), ""))
+	b.WriteString("```\n\n")
+}
+
+// writeBase stores data as the base snapshot of a target
+func writeBase(rootDir string, config *Config, target string, data []byte) error {
+	basePath := filepath.Join(rootDir, BasePath(config, target))
+	if err := os.MkdirAll(filepath.Dir(basePath), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(basePath, data, 0644)
+}
@@ -0,0 +1,137 @@
+package translate
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMerge3(t *testing.T) {
+	tests := []struct {
+		name                 string
+		base, edited, source string
+		markers              bool
+		want                 string
+		conflicts            []int // Line of each conflict in the merged file
+	}{
+		{
+			name:   "hand edit only",
+			base:   "a\nb\nc\n",
+			edited: "a\nB\nc\n",
+			source: "a\nb\nc\n",
+			want:   "a\nB\nc\n",
+		},
+		{
+			name:   "source change only",
+			base:   "a\nb\nc\n",
+			edited: "a\nb\nc\n",
+			source: "a\nb\nC\n",
+			want:   "a\nb\nC\n",
+		},
+		{
+			name:   "changes to separate lines",
+			base:   "a\nb\nc\n",
+			edited: "A\nb\nc\n",
+			source: "a\nb\nC\n",
+			want:   "A\nb\nC\n",
+		},
+		{
+			name:   "same change on both sides",
+			base:   "a\nb\nc\n",
+			edited: "a\nX\nc\n",
+			source: "a\nX\nc\n",
+			want:   "a\nX\nc\n",
+		},
+		{
+			name:      "overlapping changes keep the source",
+			base:      "a\nb\nc\n",
+			edited:    "a\nE\nc\n",
+			source:    "a\nS\nc\n",
+			want:      "a\nS\nc\n",
+			conflicts: []int{2},
+		},
+		{
+			name:      "overlapping changes marked",
+			base:      "Intro\nb\nEnd\n",
+			edited:    "Intro\nE\nEnd\n",
+			source:    "Intro\nS\nEnd\n",
+			markers:   true,
+			want:      "Intro\n\n<<<<<<< hand edit\n\nE\n\n=======\n\nS\n\n>>>>>>> new version\n\nEnd\n",
+			conflicts: []int{3},
+		},
+		{
+			name:      "markers after a blank line add no second one",
+			base:      "Intro\n\nb\n",
+			edited:    "Intro\n\nE\n",
+			source:    "Intro\n\nS\n",
+			markers:   true,
+			want:      "Intro\n\n<<<<<<< hand edit\n\nE\n\n=======\n\nS\n\n>>>>>>> new version\n\n",
+			conflicts: []int{3},
+		},
+		{
+			name:      "adjacent changes conflict",
+			base:      "a\nb\nc\nd\n",
+			edited:    "a\nB\nc\nd\n",
+			source:    "a\nb\nC\nd\n",
+			want:      "a\nb\nC\nd\n",
+			conflicts: []int{2},
+		},
+		{
+			name:   "empty base, no hand edit",
+			base:   "",
+			edited: "",
+			source: "new\n",
+			want:   "new\n",
+		},
+		{
+			name:      "empty base, both sides written",
+			base:      "",
+			edited:    "hand\n",
+			source:    "new\n",
+			want:      "new\n",
+			conflicts: []int{1},
+		},
+		{
+			name:   "last line without newline",
+			base:   "a\nb\nc",
+			edited: "a\nb\nC",
+			source: "A\nb\nc",
+			want:   "A\nb\nC",
+		},
+		{
+			name:      "conflict on a last line without newline",
+			base:      "a\nb",
+			edited:    "a\nE",
+			source:    "a\nS",
+			markers:   true,
+			want:      "a\n\n<<<<<<< hand edit\n\nE\n\n=======\n\nS\n\n>>>>>>> new version\n\n",
+			conflicts: []int{3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged, conflicts := Merge3([]byte(tt.base), []byte(tt.edited), []byte(tt.source), tt.markers)
+			if string(merged) != tt.want {
+				t.Errorf("merged = %q, want %q", merged, tt.want)
+			}
+			var lines []int
+			for _, conflict := range conflicts {
+				lines = append(lines, conflict.Line)
+			}
+			if !reflect.DeepEqual(lines, tt.conflicts) {
+				t.Errorf("conflict lines = %v, want %v", lines, tt.conflicts)
+			}
+			if got := hasConflictMarkers(merged); got != (tt.markers && len(tt.conflicts) > 0) {
+				t.Errorf("hasConflictMarkers = %v", got)
+			}
+		})
+	}
+}
+
+func TestMerge3ConflictSides(t *testing.T) {
+	_, conflicts := Merge3([]byte("a\nb\nc\n"), []byte("a\nE\nc\n"), []byte("a\nS1\nS2\nc\n"), false)
+	want := []MergeConflict{{Line: 2, Base: []string{"b\n"}, Edited: []string{"E\n"}, Source: []string{"S1\n", "S2\n"}}}
+	if !reflect.DeepEqual(conflicts, want) {
+		t.Errorf("conflicts = %+v, want %+v", conflicts, want)
+	}
+}
@@ -0,0 +1,79 @@
+package translate
+
+import (
+	"github.com/joeblew999/mon-house/pkg/translate/events"
+)
+
+// Phases of the translate full pipeline, in order
+const (
+	PhaseSync  = "sync"
+	PhaseAuto  = "auto"
+	PhaseApply = "apply"
+)
+
+// PipelinePhases lists the translate full phases in the order they run
+var PipelinePhases = []string{PhaseSync, PhaseAuto, PhaseApply}
+
+// PipelineCheckpoint is how far the last unfinished translate full run of a
+// target language got (no phases done if the last run completed)
+type PipelineCheckpoint struct {
+	TargetLanguage string
+	TaskFile       string          // Task file written by the sync phase
+	Done           map[string]bool // Phases completed
+	FailedPhase    string          // Phase that failed last ("" if none)
+	Error          string
+}
+
+// Resuming reports whether a previous run stopped after completing some phases
+func (c *PipelineCheckpoint) Resuming() bool {
+	return len(c.Done) > 0
+}
+
+// NextPhase returns the first phase not completed yet ("" when all are done)
+func (c *PipelineCheckpoint) NextPhase() string {
+	for _, phase := range PipelinePhases {
+		if !c.Done[phase] {
+			return phase
+		}
+	}
+	return ""
+}
+
+// LoadPipelineCheckpoint replays the pipeline events of a target language:
+// phase checkpoints accumulate until a PipelineCompleted starts over
+// Single entry point for translate full resume queries
+func LoadPipelineCheckpoint(rootDir string, eventsPath string, targetLanguage string) (*PipelineCheckpoint, error) {
+	records, err := events.ReadAll(rootDir, eventsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	checkpoint := &PipelineCheckpoint{TargetLanguage: targetLanguage, Done: make(map[string]bool)}
+	for _, record := range records {
+		switch record.Type {
+		case "PipelinePhaseCompleted":
+			var e events.PipelinePhaseCompleted
+			if err := record.Unmarshal(&e); err != nil || e.TargetLanguage != targetLanguage {
+				continue
+			}
+			checkpoint.Done[e.Phase] = true
+			if e.TaskFile != "" {
+				checkpoint.TaskFile = e.TaskFile
+			}
+			checkpoint.FailedPhase, checkpoint.Error = "", ""
+		case "PipelineFailed":
+			var e events.PipelineFailed
+			if err := record.Unmarshal(&e); err != nil || e.TargetLanguage != targetLanguage {
+				continue
+			}
+			checkpoint.FailedPhase, checkpoint.Error = e.Phase, e.Error
+		case "PipelineCompleted":
+			var e events.PipelineCompleted
+			if err := record.Unmarshal(&e); err != nil || e.TargetLanguage != targetLanguage {
+				continue
+			}
+			checkpoint = &PipelineCheckpoint{TargetLanguage: targetLanguage, Done: make(map[string]bool)}
+		}
+	}
+	return checkpoint, nil
+}
@@ -0,0 +1,291 @@
+package translate
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Gettext PO export/import
+// Every extraction is its own entry: msgctxt holds the extraction context (with the
+// extraction ID added only where context + source text would repeat) and the #:
+// references hold the target file and line plus file#ID, which import matches on,
+// so identical source texts can be translated differently and each finds its way back
+
+// poEntry is a single PO message
+type poEntry struct {
+	Comments   []string // #. extracted comments
+	References []string // #: file:line
+	Fuzzy      bool     // #, fuzzy
+	Context    string   // msgctxt
+	HasContext bool
+	ID         string // msgid
+	Str        string // msgstr (msgstr[0] for plural entries)
+}
+
+// exportPO renders a task as a gettext PO file
+func exportPO(task *Task) ([]byte, error) {
+	var buf bytes.Buffer
+
+	// Task-level instructions become translator comments on the header entry
+	buf.WriteString("# " + task.Task + "\n")
+	var types []string
+	for fileType := range task.Instructions {
+		types = append(types, fileType)
+	}
+	sort.Strings(types)
+	for _, fileType := range types {
+		buf.WriteString("#\n# " + fileType + ":\n")
+		for _, instruction := range task.Instructions[fileType] {
+			buf.WriteString("#   - " + instruction + "\n")
+		}
+	}
+	if len(task.TranslationNotes) > 0 {
+		buf.WriteString("#\n# Translation notes:\n")
+		for _, note := range task.TranslationNotes {
+			buf.WriteString("#   - " + note + "\n")
+		}
+	}
+
+	buf.WriteString("msgid \"\"\n")
+	buf.WriteString("msgstr \"\"\n")
+	header := []string{
+		"Project-Id-Version: mon-house\\n",
+		"Language: " + task.TargetLanguage + "\\n",
+		"MIME-Version: 1.0\\n",
+		"Content-Type: text/plain; charset=UTF-8\\n",
+		"Content-Transfer-Encoding: 8bit\\n",
+		"X-Source-Language: " + task.SourceLanguage + "\\n",
+	}
+	for _, line := range header {
+		buf.WriteString("\"" + line + "\"\n")
+	}
+
+	// msgctxt + msgid must be unique in a PO file: count the repeats
+	repeats := make(map[string]int)
+	for _, file := range task.Files {
+		for _, ext := range file.Extractions {
+			repeats[ext.Context+"\x00"+ext.SourceText]++
+		}
+	}
+
+	// One entry per extraction, referenced by file:line and file#ID
+	for _, file := range task.Files {
+		for _, ext := range file.Extractions {
+			entry := &poEntry{
+				Context:    ext.Context,
+				HasContext: ext.Context != "",
+				ID:         ext.SourceText,
+				Str:        ext.TargetText,
+			}
+			if repeats[ext.Context+"\x00"+ext.SourceText] > 1 {
+				entry.Context = strings.TrimSpace(ext.Context + " " + ext.ID)
+				entry.HasContext = true
+			}
+			if ext.Line > 0 {
+				entry.References = append(entry.References, fmt.Sprintf("%s:%d", file.Target, ext.Line))
+			}
+			entry.References = append(entry.References, file.Target+"#"+ext.ID)
+			if entry.Str == "" && ext.Suggestion != "" {
+				entry.Str = ext.Suggestion
+				entry.Fuzzy = true
+			}
+			writePOEntry(&buf, entry)
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+// writePOEntry writes one message with its comments, reference and flags
+func writePOEntry(buf *bytes.Buffer, entry *poEntry) {
+	buf.WriteString("\n")
+	for _, comment := range entry.Comments {
+		buf.WriteString("#. " + comment + "\n")
+	}
+	if len(entry.References) > 0 {
+		buf.WriteString("#: " + strings.Join(entry.References, " ") + "\n")
+	}
+	if entry.Fuzzy {
+		buf.WriteString("#, fuzzy\n")
+	}
+	if entry.HasContext {
+		buf.WriteString("msgctxt " + poQuote(entry.Context) + "\n")
+	}
+	buf.WriteString("msgid " + poQuote(entry.ID) + "\n")
+	buf.WriteString("msgstr " + poQuote(entry.Str) + "\n")
+}
+
+// parsePO reads translated entries from a gettext PO file
+// An entry with a file#ID reference matches that extraction; other entries (PO
+// files from elsewhere) match every extraction with the same source text and
+// msgctxt as context
+func parsePO(data []byte) ([]exchangeUnit, error) {
+	entries, err := parsePOEntries(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var units []exchangeUnit
+	for _, entry := range entries {
+		if entry.ID == "" {
+			continue // Header entry
+		}
+		unit := exchangeUnit{
+			SourceText: entry.ID,
+			TargetText: entry.Str,
+			Fuzzy:      entry.Fuzzy,
+		}
+		for _, reference := range entry.References {
+			if file, id, ok := poIDReference(reference); ok {
+				unit.File, unit.ID = file, id
+				break
+			}
+		}
+		if unit.ID == "" {
+			unit.Context = entry.Context
+		}
+		units = append(units, unit)
+	}
+
+	return units, nil
+}
+
+// poIDReference splits a "file#path#hash" reference into file and extraction ID
+// ("file:line" references have no ID)
+func poIDReference(reference string) (string, string, bool) {
+	file, id, ok := strings.Cut(reference, "#")
+	if !ok || file == "" || !strings.Contains(id, "#") {
+		return "", "", false
+	}
+	return file, id, true
+}
+
+// parsePOEntries parses the entries of a PO file
+func parsePOEntries(data []byte) ([]*poEntry, error) {
+	var entries []*poEntry
+	current := &poEntry{}
+	started := false
+	field := "" // keyword the next continuation string belongs to
+
+	flush := func() {
+		if started {
+			entries = append(entries, current)
+		}
+		current = &poEntry{}
+		started = false
+		field = ""
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+
+		switch {
+		case line == "":
+			flush()
+
+		case strings.HasPrefix(line, "#~"):
+			// Obsolete entry - ignored
+
+		case strings.HasPrefix(line, "#"):
+			// A comment after a msgstr starts a new entry
+			if field == "msgstr" {
+				flush()
+			}
+			if strings.HasPrefix(line, "#,") && strings.Contains(line, "fuzzy") {
+				current.Fuzzy = true
+			} else if strings.HasPrefix(line, "#:") {
+				current.References = append(current.References, strings.Fields(line[2:])...)
+			} else if strings.HasPrefix(line, "#.") {
+				current.Comments = append(current.Comments, strings.TrimSpace(line[2:]))
+			}
+
+		case strings.HasPrefix(line, "\""):
+			value, err := strconv.Unquote(line)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid string: %w", lineNum, err)
+			}
+			switch field {
+			case "msgctxt":
+				current.Context += value
+			case "msgid":
+				current.ID += value
+			case "msgstr":
+				current.Str += value
+			case "skip":
+			case "":
+				return nil, fmt.Errorf("line %d: string without keyword", lineNum)
+			}
+
+		default:
+			keyword, rest, ok := strings.Cut(line, " ")
+			if !ok {
+				return nil, fmt.Errorf("line %d: unexpected %q", lineNum, line)
+			}
+			value, err := strconv.Unquote(strings.TrimSpace(rest))
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid string: %w", lineNum, err)
+			}
+
+			switch {
+			case keyword == "msgctxt":
+				if field == "msgstr" {
+					flush()
+				}
+				current.Context, current.HasContext = value, true
+				field = "msgctxt"
+			case keyword == "msgid":
+				if field == "msgstr" {
+					flush()
+				}
+				current.ID = value
+				field = "msgid"
+			case keyword == "msgstr" || keyword == "msgstr[0]":
+				current.Str = value
+				field = "msgstr"
+			case keyword == "msgid_plural" || strings.HasPrefix(keyword, "msgstr["):
+				field = "skip" // Plural forms are never generated by mon-tool
+			default:
+				return nil, fmt.Errorf("line %d: unknown keyword %q", lineNum, keyword)
+			}
+			started = true
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read PO file: %w", err)
+	}
+	flush()
+
+	return entries, nil
+}
+
+// poQuote quotes a string for a PO file (same escapes as C strings)
+func poQuote(s string) string {
+	var sb strings.Builder
+	sb.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '\\':
+			sb.WriteString(`\\`)
+		case '"':
+			sb.WriteString(`\"`)
+		case '\n':
+			sb.WriteString(`\n`)
+		case '\t':
+			sb.WriteString(`\t`)
+		case '\r':
+			sb.WriteString(`\r`)
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
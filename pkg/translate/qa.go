@@ -0,0 +1,271 @@
+package translate
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// QA severities
+const (
+	QAError   = "error"   // Translation would break the target (apply refuses unless forced)
+	QAWarning = "warning" // Probably wrong, needs a human look
+)
+
+// QAIssue is one problem found in a translation
+type QAIssue struct {
+	File       string
+	ID         string
+	Line       int
+	Severity   string
+	Check      string // "empty", "identical", "numbers", "markup", "length", "script"
+	Message    string
+	SourceText string
+	TargetText string
+}
+
+// QAReport is the result of a QA pass
+type QAReport struct {
+	Checked int // Translations checked
+	Issues  []QAIssue
+}
+
+// Count returns the number of issues with a severity
+func (r *QAReport) Count(severity string) int {
+	count := 0
+	for _, issue := range r.Issues {
+		if issue.Severity == severity {
+			count++
+		}
+	}
+	return count
+}
+
+// HasErrors reports whether any issue is an error
+func (r *QAReport) HasErrors() bool {
+	return r.Count(QAError) > 0
+}
+
+var (
+	// qaNumber matches numbers, including decimals ("3.0", "0,9")
+	qaNumber = regexp.MustCompile(`\d+(?:[.,]\d+)*`)
+	// qaDimension matches a number with a unit ("3.0m", "90 mm", "45°"); the
+	// trailing group rejects units that are the start of a word ("3 more")
+	qaDimension = regexp.MustCompile(`(\d+(?:[.,]\d+)*)\s?(mm|cm|km|kg|px|m²|m2|m|%|°)([A-Za-z]?)`)
+	// qaPlaceholder matches ⟦n⟧ placeholder tokens
+	qaPlaceholder = regexp.MustCompile(`⟦\d+⟧`)
+	// qaLatinWord matches a run of Latin letters
+	qaLatinWord = regexp.MustCompile(`[A-Za-z]+`)
+)
+
+// qaScripts maps target languages with a non-Latin script to that script
+var qaScripts = map[string]*unicode.RangeTable{
+	"th": unicode.Thai,
+	"lo": unicode.Lao,
+	"km": unicode.Khmer,
+	"ru": unicode.Cyrillic,
+	"uk": unicode.Cyrillic,
+	"el": unicode.Greek,
+	"ar": unicode.Arabic,
+	"he": unicode.Hebrew,
+	"zh": unicode.Han,
+	"ja": unicode.Han,
+	"ko": unicode.Hangul,
+}
+
+// qaUnits are Latin tokens allowed in non-Latin output
+var qaUnits = map[string]bool{"mm": true, "cm": true, "m": true, "km": true, "kg": true, "px": true}
+
+// CheckTask runs the QA checks over every extraction of a task
+// Single entry point for translation QA
+// Empty translations are reported as warnings (they are not applied);
+// everything that would corrupt the target file is an error
+// Latin words may stay in non-Latin output when a glossary translation or a
+// do-not-translate term has them (glossary may be nil)
+func CheckTask(task *Task, glossary *Glossary, doNotTranslate []string) *QAReport {
+	report := &QAReport{}
+	kept := qaKeptWords(glossary, doNotTranslate)
+	for _, file := range task.Files {
+		for _, ext := range file.Extractions {
+			report.Checked++
+			for _, issue := range checkTranslation(task.TargetLanguage, file.Type, ext, kept) {
+				issue.File = file.Target
+				issue.ID = ext.ID
+				issue.Line = ext.Line
+				issue.SourceText = ext.SourceText
+				issue.TargetText = ext.TargetText
+				report.Issues = append(report.Issues, issue)
+			}
+		}
+	}
+	return report
+}
+
+// CheckMemory runs the QA checks over applied translations (the translation memory)
+// for a language pair
+// Memory entries do not record their file type, so the Markdown markup check is skipped
+func CheckMemory(memory *Memory, sourceLang, targetLang string, glossary *Glossary, doNotTranslate []string) *QAReport {
+	report := &QAReport{}
+	kept := qaKeptWords(glossary, doNotTranslate)
+	for _, entry := range memory.Entries(sourceLang, targetLang) {
+		report.Checked++
+		ext := TextExtraction{SourceText: entry.SourceText, TargetText: entry.TargetText}
+		for _, issue := range checkTranslation(targetLang, "", ext, kept) {
+			issue.File = "memory"
+			issue.SourceText = entry.SourceText
+			issue.TargetText = entry.TargetText
+			report.Issues = append(report.Issues, issue)
+		}
+	}
+	return report
+}
+
+// checkTranslation runs every check on one extraction of a file type
+// kept holds the lower-cased Latin words allowed in non-Latin output
+func checkTranslation(targetLang string, fileType string, ext TextExtraction, kept map[string]bool) []QAIssue {
+	var issues []QAIssue
+	add := func(severity, check, format string, args ...interface{}) {
+		issues = append(issues, QAIssue{Severity: severity, Check: check, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if ext.TargetText == "" {
+		add(QAWarning, "empty", "not translated (will not be applied)")
+		return issues
+	}
+	if strings.TrimSpace(ext.TargetText) == "" {
+		add(QAError, "empty", "translation is only whitespace")
+		return issues
+	}
+
+	// Placeholders are checked as markup; numbers and lengths ignore them
+	source := qaPlaceholder.ReplaceAllString(ext.SourceText, "")
+	target := qaPlaceholder.ReplaceAllString(ext.TargetText, "")
+
+	if ext.TargetText == ext.SourceText && strings.IndexFunc(source, unicode.IsLetter) >= 0 {
+		add(QAWarning, "identical", "translation is identical to the source")
+	}
+
+	// Numbers and dimensions
+	sourceNumbers := qaNumbers(source)
+	targetNumbers := qaNumbers(target)
+	for _, number := range sortedKeys(sourceNumbers) {
+		if targetNumbers[number] < sourceNumbers[number] {
+			add(QAError, "numbers", "number %s is missing", number)
+		}
+	}
+	for _, number := range sortedKeys(targetNumbers) {
+		if targetNumbers[number] > sourceNumbers[number] {
+			add(QAWarning, "numbers", "number %s is not in the source", number)
+		}
+	}
+	targetDimensions := qaDimensions(target)
+	for _, dimension := range sortedKeys(qaDimensions(source)) {
+		if targetDimensions[dimension] == 0 {
+			add(QAWarning, "numbers", "dimension %s lost its unit", dimension)
+		}
+	}
+
+	// Markup: placeholders exactly once; in Markdown also balanced bold markers, links and code
+	for token, original := range ext.Placeholders {
+		if n := strings.Count(ext.TargetText, token); n != 1 {
+			add(QAError, "markup", "placeholder %s (%s) appears %d times", token, original, n)
+		}
+	}
+	if fileType == "md" {
+		for _, marker := range []string{"**", "`", "[", "]"} {
+			if s, t := strings.Count(ext.SourceText, marker), strings.Count(ext.TargetText, marker); s != t {
+				add(QAError, "markup", "%q appears %d times in the source but %d in the translation", marker, s, t)
+			}
+		}
+	}
+
+	// Length ratio (short texts vary too much to judge)
+	sourceLen := utf8.RuneCountInString(source)
+	targetLen := utf8.RuneCountInString(target)
+	if sourceLen >= 10 {
+		ratio := float64(targetLen) / float64(sourceLen)
+		if ratio > 3 || ratio < 0.33 {
+			add(QAWarning, "length", "translation is %.1fx the source length", ratio)
+		}
+	}
+
+	// Leftover Latin words in non-Latin output (units, glossary and do-not-translate terms are fine)
+	if _, ok := qaScripts[targetLang]; ok {
+		var leftover []string
+		for _, word := range qaLatinWord.FindAllString(target, -1) {
+			if len(word) < 2 || qaUnits[word] || kept[strings.ToLower(word)] {
+				continue
+			}
+			leftover = append(leftover, word)
+		}
+		if len(leftover) > 0 {
+			add(QAWarning, "script", "Latin words left in the translation: %s", strings.Join(leftover, ", "))
+		}
+	}
+
+	return issues
+}
+
+// qaKeptWords returns the lower-cased Latin words of the glossary translations
+// and the do-not-translate terms (glossary may be nil)
+func qaKeptWords(glossary *Glossary, doNotTranslate []string) map[string]bool {
+	terms := append([]string(nil), doNotTranslate...)
+	if glossary != nil {
+		for _, term := range glossary.Terms {
+			terms = append(terms, term.Target)
+		}
+	}
+
+	kept := make(map[string]bool)
+	for _, term := range terms {
+		for _, word := range qaLatinWord.FindAllString(term, -1) {
+			kept[strings.ToLower(word)] = true
+		}
+	}
+	return kept
+}
+
+// qaNumbers counts the numbers in a text (Thai digits count as ASCII digits,
+// decimal commas as points)
+func qaNumbers(s string) map[string]int {
+	counts := make(map[string]int)
+	for _, number := range qaNumber.FindAllString(normalizeDigits(s), -1) {
+		counts[strings.ReplaceAll(number, ",", ".")]++
+	}
+	return counts
+}
+
+// qaDimensions counts the number+unit pairs in a text ("3.0m")
+func qaDimensions(s string) map[string]int {
+	counts := make(map[string]int)
+	for _, match := range qaDimension.FindAllStringSubmatch(normalizeDigits(s), -1) {
+		if match[3] != "" {
+			continue
+		}
+		counts[strings.ReplaceAll(match[1], ",", ".")+match[2]]++
+	}
+	return counts
+}
+
+// normalizeDigits replaces Thai digits (๐-๙) with ASCII digits
+func normalizeDigits(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '๐' && r <= '๙' {
+			return '0' + (r - '๐')
+		}
+		return r
+	}, s)
+}
+
+// sortedKeys returns the keys of a count map in order (stable output)
+func sortedKeys(m map[string]int) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,208 @@
+package translate
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// When only the geometry of a source changes (a wall moves), its text units keep
+// their IDs. Sync reads the translated target before copying the new source over
+// it and writes the translations of unchanged units straight back, so only new
+// or changed strings go into the task
+
+// CarriedFile is a translated target whose translations survive a resync
+type CarriedFile struct {
+	Source      string           // Relative to the root
+	SourceHash  string           // Content hash of the new source
+	Target      string           // Relative to the root
+	Type        string           // "svg", "md", "json"
+	Extractions []TextExtraction // Units of the new source, filled from the old target
+	Changed     int              // Units of the new source left for the task
+}
+
+// PlanCarry pairs the units of each changed source with the translations in the
+// target that sync is about to overwrite (QUERY - must run before ExecuteSync)
+// A unit is carried when the last apply wrote its extraction ID (same path, same
+// source text) or, if the element moved, the single applied unit with its source hash
+// Its translation comes from the translation memory (exactly what apply wrote for
+// that source text); the old target is only read for units the memory lacks
+// Targets the event log has no applied IDs for are not carried; memory may be nil
+func PlanCarry(rootDir string, config *Config, target TargetConfig, actions []SyncAction, memory *Memory) ([]CarriedFile, error) {
+	history, err := loadFileHistory(rootDir, config.Paths.Events)
+	if err != nil {
+		return nil, err
+	}
+
+	var carried []CarriedFile
+	for _, action := range actions {
+		if action.Action != "copy" || action.Type == "other" {
+			continue
+		}
+		past, ok := history[relativePath(rootDir, action.Target)]
+		if !ok || !past.translated || len(past.ids) == 0 {
+			continue
+		}
+		if _, err := os.Stat(action.Target); err != nil {
+			continue
+		}
+
+		file, err := carryFile(rootDir, config, target, action, past.ids, memory)
+		if err != nil {
+			return nil, err
+		}
+		if len(file.Extractions) > 0 {
+			carried = append(carried, file)
+		}
+	}
+	return carried, nil
+}
+
+// CarryTranslations writes planned translations into the fresh copies of their
+// sources (COMMAND - run after ExecuteSync)
+// Returns the files as actually carried: units that could not be written count as
+// changed again, so they go into the task instead of being lost
+// Single entry point for structure-preserving resync
+func CarryTranslations(rootDir string, config *Config, target TargetConfig, carried []CarriedFile) ([]CarriedFile, *ApplyStats, error) {
+	task := &Task{
+		SourceLanguage: config.Source.Language,
+		TargetLanguage: target.Language,
+		LanguageName:   target.LanguageName,
+	}
+	for _, file := range carried {
+		task.Files = append(task.Files, TaskFile{
+			Source:      file.Source,
+			SourceHash:  file.SourceHash,
+			Target:      file.Target,
+			Type:        file.Type,
+			Extractions: file.Extractions,
+		})
+	}
+	if len(task.Files) == 0 {
+		return nil, &ApplyStats{}, nil
+	}
+	stats, err := ApplyTranslations(rootDir, task, config)
+	if err != nil {
+		return nil, stats, err
+	}
+
+	unresolved := make(map[string]bool)
+	for _, u := range stats.Unresolved {
+		unresolved[u.File+"\x00"+u.ID] = true
+	}
+	var written []CarriedFile
+	for _, file := range carried {
+		var extractions []TextExtraction
+		for _, ext := range file.Extractions {
+			if unresolved[file.Target+"\x00"+ext.ID] {
+				file.Changed++
+				continue
+			}
+			extractions = append(extractions, ext)
+		}
+		file.Extractions = extractions
+		if len(file.Extractions) > 0 {
+			written = append(written, file)
+		}
+	}
+	return written, stats, nil
+}
+
+// carryFile pairs the units of one new source with the old target's translations
+func carryFile(rootDir string, config *Config, target TargetConfig, action SyncAction, applied map[string]bool, memory *Memory) (CarriedFile, error) {
+	file := CarriedFile{
+		Source: relativePath(rootDir, action.Source),
+		Target: relativePath(rootDir, action.Target),
+		Type:   action.Type,
+	}
+	file.SourceHash, _ = FileHash(action.Source)
+
+	sources, err := ExtractText(action.Source, action.Type, config)
+	if err != nil {
+		return file, fmt.Errorf("failed to extract text from %s: %w", file.Source, err)
+	}
+	// Translated text by element path, read from the old target only when needed
+	// (extraction joins wrapped SVG lines with spaces, which Thai text must not get)
+	var translated map[string]string
+	targetText := func(path string) (string, error) {
+		if translated == nil {
+			translated = make(map[string]string)
+			// Unresolved conflict markers shift element paths: carry nothing from the file
+			if data, err := os.ReadFile(action.Target); err == nil && hasConflictMarkers(data) {
+				return "", nil
+			}
+			translations, err := extractTarget(action.Target, action.Type, config, target.Language)
+			if err != nil {
+				return "", fmt.Errorf("failed to read translations from %s: %w", file.Target, err)
+			}
+			for _, ext := range translations {
+				path, _ := splitExtractionID(ext.ID)
+				translated[path] = ext.SourceText
+			}
+		}
+		return translated[path], nil
+	}
+
+	// Applied paths by source hash
+	pathsByHash := make(map[string][]string)
+	for id := range applied {
+		path, hash := splitExtractionID(id)
+		pathsByHash[hash] = append(pathsByHash[hash], path)
+	}
+
+	used := make(map[string]bool)
+	for _, ext := range sources {
+		path, hash := splitExtractionID(ext.ID)
+		from := ""
+		if applied[ext.ID] {
+			from = path
+		} else if paths := pathsByHash[hash]; len(paths) == 1 {
+			from = paths[0] // Same source text, element moved
+		}
+		if from == "" || used[from] {
+			file.Changed++
+			continue
+		}
+
+		// Same extraction ID, same source text: the memory holds what apply wrote
+		if match, ok := lookupExact(memory, config.Source.Language, target.Language, ext.SourceText); ok {
+			ext.TargetText = match.TargetText
+			ext.Status = match.Status
+			ext.Reviewer = match.Reviewer
+			ext.ReviewedAt = match.ReviewedAt
+		} else {
+			text, err := targetText(from)
+			if err != nil {
+				return file, err
+			}
+			ext.TargetText = text
+		}
+		if ext.TargetText == "" {
+			file.Changed++
+			continue
+		}
+		used[from] = true
+		file.Extractions = append(file.Extractions, ext)
+	}
+	return file, nil
+}
+
+// lookupExact returns the memory entry for exactly this source text (memory may be nil)
+func lookupExact(memory *Memory, sourceLang, targetLang, sourceText string) (MemoryEntry, bool) {
+	if memory == nil {
+		return MemoryEntry{}, false
+	}
+	return memory.Exact(sourceLang, targetLang, sourceText)
+}
+
+// extractTarget extracts the (translated) text units of a target file
+func extractTarget(path string, fileType string, config *Config, language string) ([]TextExtraction, error) {
+	if fileType != "json" {
+		return ExtractText(path, fileType, config)
+	}
+	doc, ok := findJSONDocument(config, filepath.ToSlash(path), language)
+	if !ok {
+		return nil, fmt.Errorf("%s is not listed in json_documents", path)
+	}
+	return extractJSONText(path, doc)
+}
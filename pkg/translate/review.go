@@ -0,0 +1,131 @@
+package translate
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Review statuses of a TextExtraction
+const (
+	StatusNew      = "new"      // Not translated yet
+	StatusMachine  = "machine"  // Filled by translate auto, not looked at by a person
+	StatusFlagged  = "flagged"  // Back-translation drifted from the source (translate verify)
+	StatusFuzzy    = "fuzzy"    // Empty, with a fuzzy translation memory suggestion
+	StatusReviewed = "reviewed" // Translated or corrected by a person
+	StatusApproved = "approved" // Signed off (applied by apply --only=approved)
+)
+
+// ReviewStatuses lists the review statuses in workflow order
+var ReviewStatuses = []string{StatusNew, StatusFuzzy, StatusMachine, StatusFlagged, StatusReviewed, StatusApproved}
+
+// ExtractionStatus returns the review status of an extraction
+// Tasks written before statuses existed have none: a filled target was typed
+// in by hand (reviewed), an empty one is new (or fuzzy with a suggestion)
+func ExtractionStatus(ext TextExtraction) string {
+	if ext.Status != "" {
+		return ext.Status
+	}
+	switch {
+	case ext.TargetText != "":
+		return StatusReviewed
+	case ext.Suggestion != "":
+		return StatusFuzzy
+	default:
+		return StatusNew
+	}
+}
+
+// ReviewItem is one extraction of a task selected for review
+type ReviewItem struct {
+	File       string // Target path of the TaskFile
+	Extraction *TextExtraction
+}
+
+// SelectExtractions returns the extractions of a task matching any of ids (a full
+// extraction ID or the hash after its #) and, if status is set, having that status
+// No ids selects every extraction
+func SelectExtractions(task *Task, ids []string, status string) []ReviewItem {
+	var items []ReviewItem
+	for fileIdx := range task.Files {
+		file := &task.Files[fileIdx]
+		for extIdx := range file.Extractions {
+			ext := &file.Extractions[extIdx]
+			if status != "" && ExtractionStatus(*ext) != status {
+				continue
+			}
+			if len(ids) > 0 && !matchesID(ext.ID, ids) {
+				continue
+			}
+			items = append(items, ReviewItem{File: file.Target, Extraction: ext})
+		}
+	}
+	return items
+}
+
+// ApproveExtraction signs off an extraction's translation
+func ApproveExtraction(ext *TextExtraction, reviewer string, at time.Time) error {
+	if ext.TargetText == "" {
+		return fmt.Errorf("%s has no translation to approve", ext.ID)
+	}
+	ext.Status = StatusApproved
+	ext.Reviewer = reviewer
+	ext.ReviewedAt = &at
+	return nil
+}
+
+// RejectExtraction discards an extraction's translation so it is translated
+// again (by translate auto or by hand); returns the rejected text
+func RejectExtraction(ext *TextExtraction, reviewer string, at time.Time) (string, error) {
+	if ext.TargetText == "" {
+		return "", fmt.Errorf("%s has no translation to reject", ext.ID)
+	}
+	rejected := ext.TargetText
+	ext.TargetText = ""
+	ext.BackTranslation, ext.Drift = "", 0
+	ext.Status = StatusNew
+	if ext.Suggestion != "" {
+		ext.Status = StatusFuzzy
+	}
+	ext.Reviewer = reviewer
+	ext.ReviewedAt = &at
+	return rejected, nil
+}
+
+// CountStatuses counts the extractions of a task by review status
+func CountStatuses(task *Task) map[string]int {
+	counts := make(map[string]int)
+	for _, file := range task.Files {
+		for _, ext := range file.Extractions {
+			counts[ExtractionStatus(ext)]++
+		}
+	}
+	return counts
+}
+
+// ApprovedOnly returns a copy of the task keeping only approved translations
+// (every other target_text is emptied), for apply --only=approved
+func ApprovedOnly(task *Task) *Task {
+	approved := *task
+	approved.Files = make([]TaskFile, len(task.Files))
+	for fileIdx, file := range task.Files {
+		file.Extractions = append([]TextExtraction(nil), file.Extractions...)
+		for extIdx := range file.Extractions {
+			if ExtractionStatus(file.Extractions[extIdx]) != StatusApproved {
+				file.Extractions[extIdx].TargetText = ""
+			}
+		}
+		approved.Files[fileIdx] = file
+	}
+	return &approved
+}
+
+// matchesID reports whether an extraction ID is one of ids (or ends in #id)
+func matchesID(extractionID string, ids []string) bool {
+	for _, id := range ids {
+		if extractionID == id || strings.HasSuffix(extractionID, "#"+id) {
+			return true
+		}
+	}
+	return false
+}
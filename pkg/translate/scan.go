@@ -7,12 +7,19 @@ import (
 	"strings"
 )
 
-// ScanSource scans the source directory and builds a list of actions for syncing
+// ScanSource scans a source folder and builds a list of actions for syncing it
+// to its target folder (see Config.FolderMappings)
 // Single entry point for scanning and planning sync operations
-func ScanSource(rootDir string, sourceDir string, target TargetConfig) ([]SyncAction, error) {
-	targetDir := filepath.Join(rootDir, target.Folder)
+func ScanSource(rootDir string, folder FolderMapping, target TargetConfig) ([]SyncAction, error) {
+	sourceDir := filepath.Join(rootDir, folder.Source)
+	targetDir := filepath.Join(rootDir, folder.Target)
 	var actions []SyncAction
 
+	// A target inside its source would be copied into itself on every sync
+	if rel, err := filepath.Rel(sourceDir, targetDir); err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return nil, fmt.Errorf("target folder %s is inside source folder %s", folder.Target, folder.Source)
+	}
+
 	// Phase 1: Scan source directory and plan copy operations
 	err := filepath.Walk(sourceDir, func(sourcePath string, info os.FileInfo, err error) error {
 		if err != nil {
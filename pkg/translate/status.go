@@ -0,0 +1,300 @@
+package translate
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/joeblew999/mon-house/pkg/translate/events"
+)
+
+// File states reported by TranslationStatus
+const (
+	FileCurrent      = "current"      // Translated from the current source (or an up-to-date copy)
+	FileStale        = "stale"        // Source changed since the target was translated or copied
+	FileUntranslated = "untranslated" // Copied by sync, translations not applied yet
+	FileMissing      = "missing"      // Source file with no target yet (run sync)
+	FileOrphaned     = "orphaned"     // Target file whose source is gone (sync deletes it)
+)
+
+// FileStates lists the file states, most urgent first
+var FileStates = []string{FileMissing, FileStale, FileUntranslated, FileOrphaned, FileCurrent}
+
+// FileStatus is the state of one target file of a language
+type FileStatus struct {
+	Source         string     `json:"source,omitempty"` // Relative to the root ("" for orphans)
+	Target         string     `json:"target"`
+	Type           string     `json:"type"` // "svg", "md", "json", "other"
+	State          string     `json:"state"`
+	SourceHash     string     `json:"source_hash,omitempty"`     // Content hash of the source now
+	TranslatedHash string     `json:"translated_hash,omitempty"` // Source hash the target was last translated from
+	TranslatedAt   *time.Time `json:"translated_at,omitempty"`
+	Extractions    int        `json:"extractions"`      // Translatable units in the source now
+	Translated     int        `json:"translated"`       // Units written by the last apply
+	Coverage       float64    `json:"coverage"`         // Translated units, percent
+	Edited         bool       `json:"edited,omitempty"` // Changed by hand since sync or apply wrote it (sync merges it)
+}
+
+// LanguageStatus is the translation state of one target language
+type LanguageStatus struct {
+	Language     string         `json:"language"`
+	LanguageName string         `json:"language_name"`
+	TaskFile     string         `json:"task_file,omitempty"` // Task file waiting to be applied
+	Files        []FileStatus   `json:"files"`
+	States       map[string]int `json:"states"` // Files per state
+	Extractions  int            `json:"extractions"`
+	Translated   int            `json:"translated"`
+	Coverage     float64        `json:"coverage"`
+}
+
+// fileHistory is what the event log says about one target file
+type fileHistory struct {
+	copiedHash     string // Source hash when sync last copied it
+	translated     bool   // Applied after the last copy
+	translatedHash string
+	translatedAt   time.Time
+	applied        int
+	ids            map[string]bool // Extraction IDs written since the last copy
+}
+
+// fileHistories is the history of every target file, keyed by its path relative to the root
+type fileHistories map[string]*fileHistory
+
+// TranslationStatus reports, for each target, which files are current, stale,
+// untranslated, missing or orphaned and how much of each is translated
+// Source content hashes are compared with the hashes the event log recorded
+// when each target was last copied and translated; nothing is changed
+// Single entry point for translation status queries
+func TranslationStatus(rootDir string, config *Config, targets []TargetConfig) ([]LanguageStatus, error) {
+	// Step 1: Replay the copy and apply events per target file
+	history, err := loadFileHistory(rootDir, config.Paths.Events)
+	if err != nil {
+		return nil, err
+	}
+
+	var statuses []LanguageStatus
+	for _, target := range targets {
+		status := LanguageStatus{
+			Language:     target.Language,
+			LanguageName: target.LanguageName,
+			States:       make(map[string]int),
+		}
+
+		// Step 2: Plan the sync without running it: copies are the source files, deletes the orphans
+		var actions []SyncAction
+		for _, folder := range config.FolderMappings(target) {
+			folderActions, err := ScanSource(rootDir, folder, target)
+			if err != nil {
+				return nil, fmt.Errorf("failed to scan source %s: %w", folder.Source, err)
+			}
+			actions = append(actions, folderActions...)
+		}
+		jsonActions, err := ScanJSONDocuments(rootDir, config.JSONDocuments, target)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan JSON documents: %w", err)
+		}
+		actions = append(actions, jsonActions...)
+
+		// Step 3: Work out the state and coverage of each file
+		for _, action := range actions {
+			var file FileStatus
+			switch action.Action {
+			case "copy":
+				file, err = fileStatus(rootDir, config, action, history)
+				if err != nil {
+					return nil, err
+				}
+			case "delete":
+				if info, err := os.Stat(action.Target); err != nil || info.IsDir() {
+					continue
+				}
+				file = FileStatus{Target: relativePath(rootDir, action.Target), Type: determineFileType(action.Target), State: FileOrphaned}
+			default:
+				continue
+			}
+			status.Files = append(status.Files, file)
+			status.States[file.State]++
+			status.Extractions += file.Extractions
+			status.Translated += file.Translated
+		}
+		status.Coverage = coverage(status.Translated, status.Extractions)
+		sort.SliceStable(status.Files, func(i, j int) bool { return status.Files[i].Target < status.Files[j].Target })
+
+		// Step 4: Point at a task file that was generated but not applied yet
+		taskFile := filepath.Join(config.Paths.Tasks, fmt.Sprintf("translate-%s.json", target.Language))
+		if _, err := os.Stat(filepath.Join(rootDir, taskFile)); err == nil {
+			status.TaskFile = taskFile
+		}
+
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// FileHash returns the content hash of a file, as recorded in the event log
+func FileHash(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])[:12], nil
+}
+
+// fileStatus works out the state of the target of a planned copy
+func fileStatus(rootDir string, config *Config, action SyncAction, history fileHistories) (FileStatus, error) {
+	file := FileStatus{
+		Source: relativePath(rootDir, action.Source),
+		Target: relativePath(rootDir, action.Target),
+		Type:   action.Type,
+	}
+	hash, err := FileHash(action.Source)
+	if err != nil {
+		return file, fmt.Errorf("failed to read source %s: %w", file.Source, err)
+	}
+	file.SourceHash = hash
+
+	var extractions []TextExtraction
+	if action.Type != "other" {
+		extractions, err = ExtractText(action.Source, action.Type, config)
+		if err != nil {
+			return file, fmt.Errorf("failed to extract text from %s: %w", file.Source, err)
+		}
+		file.Extractions = len(extractions)
+	}
+
+	targetHash, err := FileHash(action.Target)
+	if err != nil {
+		file.State = FileMissing
+		file.Coverage = coverage(0, file.Extractions)
+		return file, nil
+	}
+	if baseHash, err := FileHash(filepath.Join(rootDir, BasePath(config, file.Target))); err == nil {
+		file.Edited = baseHash != targetHash
+	}
+
+	// Plain copies (and files with nothing to translate) are current while they match the source
+	if file.Extractions == 0 {
+		file.State = FileCurrent
+		if targetHash != hash {
+			file.State = FileStale
+		}
+		file.Coverage = coverage(0, 0)
+		return file, nil
+	}
+
+	past, ok := history[file.Target]
+	switch {
+	case ok && past.translated:
+		file.TranslatedHash = past.translatedHash
+		file.TranslatedAt = &past.translatedAt
+		file.Translated = min(past.applied, file.Extractions)
+		if len(past.ids) > 0 {
+			file.Translated = 0
+			for _, ext := range extractions {
+				if past.ids[ext.ID] {
+					file.Translated++
+				}
+			}
+		}
+		file.State = FileCurrent
+		if past.translatedHash != hash {
+			file.State = FileStale
+		}
+	case targetHash == hash || file.Edited:
+		file.State = FileUntranslated // A fresh copy of the source (perhaps translated by hand)
+	case ok && past.copiedHash != "" && past.copiedHash != hash:
+		file.State = FileStale // Copied from an older source, never translated
+	default:
+		file.State = FileStale // Changed by hand or translated before the event log
+	}
+	file.Coverage = coverage(file.Translated, file.Extractions)
+	return file, nil
+}
+
+// loadFileHistory replays FileCopied, TranslationApplied, TranslationsCarried and
+// FileDeleted events into the history of each target file (keyed by its path
+// relative to the root)
+func loadFileHistory(rootDir string, eventsPath string) (fileHistories, error) {
+	records, err := events.ReadAll(rootDir, eventsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	history := make(fileHistories)
+	for _, record := range records {
+		switch record.Type {
+		case "FileCopied":
+			var e events.FileCopied
+			if err := record.Unmarshal(&e); err != nil {
+				continue
+			}
+			// A copy overwrites the translated target with the source again
+			history[relativePath(rootDir, e.TargetPath)] = &fileHistory{copiedHash: e.SourceHash}
+		case "TranslationApplied":
+			var e events.TranslationApplied
+			if err := record.Unmarshal(&e); err != nil || e.AppliedCount == 0 {
+				continue
+			}
+			history.translated(relativePath(rootDir, e.FilePath), e.SourceHash, e.Occurred, e.AppliedCount, e.ExtractionIDs)
+		case "TranslationsCarried":
+			var e events.TranslationsCarried
+			if err := record.Unmarshal(&e); err != nil || e.CarriedCount == 0 {
+				continue
+			}
+			history.translated(relativePath(rootDir, e.FilePath), e.SourceHash, e.Occurred, e.CarriedCount, e.ExtractionIDs)
+		case "FileDeleted":
+			var e events.FileDeleted
+			if err := record.Unmarshal(&e); err != nil {
+				continue
+			}
+			delete(history, relativePath(rootDir, e.Path))
+		}
+	}
+	return history, nil
+}
+
+// translated records translations written into a target since its last copy
+// (units add up over several applies when the IDs are known)
+func (h fileHistories) translated(path string, sourceHash string, at time.Time, count int, ids []string) {
+	past, ok := h[path]
+	if !ok {
+		past = &fileHistory{}
+		h[path] = past
+	}
+	past.translated = true
+	past.translatedHash = sourceHash
+	past.translatedAt = at
+	past.applied = count
+	if len(ids) > 0 {
+		if past.ids == nil {
+			past.ids = make(map[string]bool)
+		}
+		for _, id := range ids {
+			past.ids[id] = true
+		}
+	}
+}
+
+// relativePath returns path relative to the root (events record both forms)
+func relativePath(rootDir string, path string) string {
+	if !filepath.IsAbs(path) {
+		return filepath.Clean(path)
+	}
+	if rel, err := filepath.Rel(rootDir, path); err == nil {
+		return rel
+	}
+	return path
+}
+
+// coverage returns translated as a percentage of total (100 when there is nothing to translate)
+func coverage(translated, total int) float64 {
+	if total == 0 {
+		return 100
+	}
+	return float64(translated) * 100 / float64(total)
+}
@@ -80,7 +80,7 @@ func GetSyncStats(actions []SyncAction) (mkdirs, copies, deletes int) {
 func GetTranslatableFiles(actions []SyncAction) []string {
 	var files []string
 	for _, action := range actions {
-		if action.Action == "copy" && (action.Type == "svg" || action.Type == "md") {
+		if action.Action == "copy" && (action.Type == "svg" || action.Type == "md" || action.Type == "json") {
 			files = append(files, action.Target)
 		}
 	}
@@ -10,16 +10,30 @@ import (
 
 // GenerateTask generates a translation task JSON file
 // Single entry point for task generation
-// Returns the total number of extractions in the task
-func GenerateTask(rootDir string, target TargetConfig, files []string, tasksPath string) (int, error) {
+// Extractions found in the translation memory are pre-filled (exact) or
+// marked with a suggestion (fuzzy); memory may be nil
+// Extractions sync carried across from the old target (see PlanCarry) are left out
+func GenerateTask(rootDir string, config *Config, target TargetConfig, files []string, memory *Memory, carried []CarriedFile) (*GenerateStats, error) {
+	sourceLanguage := config.Source.Language
+
+	carriedIDs := make(map[string]bool)
+	for _, file := range carried {
+		for _, ext := range file.Extractions {
+			carriedIDs[file.Target+"\x00"+ext.ID] = true
+		}
+	}
+
 	// Build file list with source and target paths and extractions
 	var taskFiles []TaskFile
-	totalExtractions := 0
+	stats := &GenerateStats{}
 	for _, targetPath := range files {
 		relTargetPath, _ := filepath.Rel(rootDir, targetPath)
 
-		// Derive source path
-		sourcePath := deriveSourcePath(relTargetPath, target)
+		// Derive source path from the configured folder mappings and rename rules
+		sourcePath, err := deriveSourcePath(relTargetPath, config, target)
+		if err != nil {
+			return nil, err
+		}
 
 		// Determine file type
 		fileType := "other"
@@ -27,19 +41,63 @@ func GenerateTask(rootDir string, target TargetConfig, files []string, tasksPath
 			fileType = "svg"
 		} else if strings.HasSuffix(targetPath, ".md") {
 			fileType = "md"
+		} else if strings.HasSuffix(targetPath, ".json") {
+			fileType = "json"
 		}
 
 		// Extract text from source file
 		sourceFullPath := filepath.Join(rootDir, sourcePath)
-		extractions, err := ExtractText(sourceFullPath, fileType)
+		extractions, err := ExtractText(sourceFullPath, fileType, config)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Warning: failed to extract text from %s: %v\n", sourcePath, err)
 		}
+		if len(carriedIDs) > 0 {
+			remaining := extractions[:0]
+			for _, ext := range extractions {
+				if !carriedIDs[relTargetPath+"\x00"+ext.ID] {
+					remaining = append(remaining, ext)
+				}
+			}
+			extractions = remaining
+		}
+
+		stats.TotalExtractions += len(extractions)
+		hash, _ := FileHash(sourceFullPath)
 
-		totalExtractions += len(extractions)
+		// Reuse earlier translations from the translation memory
+		if memory != nil {
+			for i := range extractions {
+				match, ok := memory.Lookup(sourceLanguage, target.Language, extractions[i].SourceText)
+				if !ok {
+					continue
+				}
+				if match.Score == 1.0 {
+					extractions[i].TargetText = match.Entry.TargetText
+					extractions[i].Status = match.Entry.Status
+					extractions[i].Reviewer = match.Entry.Reviewer
+					extractions[i].ReviewedAt = match.Entry.ReviewedAt
+					extractions[i].Match = "exact"
+					extractions[i].MatchScore = 100
+					stats.ExactMatches++
+				} else {
+					extractions[i].Suggestion = match.Entry.TargetText
+					extractions[i].Match = "fuzzy"
+					extractions[i].Status = StatusFuzzy
+					extractions[i].MatchScore = int(match.Score * 100)
+					stats.FuzzyMatches++
+				}
+			}
+		}
+
+		for i := range extractions {
+			if extractions[i].TargetText == "" && extractions[i].Status == "" {
+				extractions[i].Status = StatusNew
+			}
+		}
 
 		taskFiles = append(taskFiles, TaskFile{
 			Source:      sourcePath,
+			SourceHash:  hash,
 			Target:      relTargetPath,
 			Type:        fileType,
 			Extractions: extractions,
@@ -48,58 +106,85 @@ func GenerateTask(rootDir string, target TargetConfig, files []string, tasksPath
 
 	// Build task structure
 	task := Task{
-		Task:             fmt.Sprintf("Translate English to %s for architectural drawings", target.LanguageName),
-		SourceLanguage:   "en",
+		Task:             fmt.Sprintf("Translate %s to %s for architectural drawings", config.Source.LanguageName, target.LanguageName),
+		SourceLanguage:   sourceLanguage,
 		TargetLanguage:   target.Language,
 		LanguageName:     target.LanguageName,
 		Files:            taskFiles,
 		TranslationNotes: target.TranslationNotes,
 		Instructions: map[string][]string{
-			"svg": {
-				"Translate all <text> element content",
-				"Translate <title> element content",
-				"DO NOT translate: attributes, CSS classes, coordinates, numbers",
-				"Preserve: XML structure, formatting, indentation",
-			},
+			"svg": svgInstructions(config.SVG),
 			"markdown": {
-				"Translate all text content",
+				"Translate all text content (one unit per paragraph, heading, list item, table cell)",
 				"DO NOT translate: code blocks, file paths, URLs",
-				"Preserve: markdown formatting, structure, links",
+				"Keep every ⟦n⟧ placeholder exactly once (they protect link targets, image sources and inline code)",
+				"Preserve: inline markdown formatting such as **bold** and link text brackets",
+				"Keep the line breaks of multi-line units (a line ending in two spaces is a hard break)",
+			},
+			"json": {
+				"Translate the selected string values (titles, subtitles, legend text)",
+				"DO NOT translate: file paths, units, scale ratios such as 1:100",
+			},
+			"memory": {
+				"match=exact: pre-filled from translation memory, review only",
+				"match=fuzzy: suggestion comes from a similar earlier source text, adapt it into target_text",
 			},
 		},
 	}
 
 	// Create tasks directory (path from config, not hardcoded)
-	tasksDir := filepath.Join(rootDir, tasksPath)
+	tasksDir := filepath.Join(rootDir, config.Paths.Tasks)
 	if err := os.MkdirAll(tasksDir, 0755); err != nil {
-		return 0, fmt.Errorf("failed to create tasks directory: %w", err)
+		return nil, fmt.Errorf("failed to create tasks directory: %w", err)
 	}
 
 	// Write task JSON file
 	taskPath := filepath.Join(tasksDir, fmt.Sprintf("translate-%s.json", target.Language))
 	jsonData, err := json.MarshalIndent(task, "", "  ")
 	if err != nil {
-		return 0, fmt.Errorf("failed to marshal JSON: %w", err)
+		return nil, fmt.Errorf("failed to marshal JSON: %w", err)
 	}
 
 	if err := os.WriteFile(taskPath, jsonData, 0644); err != nil {
-		return 0, fmt.Errorf("failed to write task file: %w", err)
+		return nil, fmt.Errorf("failed to write task file: %w", err)
 	}
 
-	return totalExtractions, nil
+	return stats, nil
+}
+
+// svgInstructions describes the configured translatable SVG content for the translator
+func svgInstructions(svg SVGConfig) []string {
+	instructions := []string{
+		fmt.Sprintf("Translate the content of <%s> elements", strings.Join(svg.Elements, ">, <")),
+		"A label split over several <tspan> lines is ONE unit; apply spreads the translation back over the same lines",
+	}
+	if len(svg.Attributes) > 0 {
+		instructions = append(instructions, fmt.Sprintf("Translate the %s attribute values (xpath ends in /@name)", strings.Join(svg.Attributes, ", ")))
+	}
+	return append(instructions,
+		"DO NOT translate: CSS classes, ids, coordinates, numbers",
+		"Preserve: XML structure, formatting, indentation",
+	)
 }
 
-// deriveSourcePath derives the source path from a target path
-func deriveSourcePath(relTargetPath string, target TargetConfig) string {
-	sourcePath := relTargetPath
-	// Convert from target folder to source folder (e.g., drawings/th -> drawings/en)
-	sourcePath = strings.Replace(sourcePath, target.Folder+"/", "drawings/en/", 1)
-	// Reverse rename rules (e.g., .th.md -> .md)
-	for oldExt, newExt := range target.RenameRules {
-		if strings.HasSuffix(sourcePath, newExt) {
-			sourcePath = strings.TrimSuffix(sourcePath, newExt) + oldExt
-			break
+// deriveSourcePath derives the source path from a target path (both relative to root)
+// JSON documents map to their configured source; other files are found by the
+// folder mapping whose target contains them, with rename rules reversed
+// (e.g. drawings/th/README.th.md -> drawings/en/README.md)
+func deriveSourcePath(relTargetPath string, config *Config, target TargetConfig) (string, error) {
+	if doc, ok := findJSONDocument(config, relTargetPath, target.Language); ok {
+		return doc.Source, nil
+	}
+
+	slashPath := filepath.ToSlash(relTargetPath)
+	for _, folder := range config.FolderMappings(target) {
+		prefix := strings.TrimSuffix(filepath.ToSlash(filepath.Clean(folder.Target)), "/") + "/"
+		if !strings.HasPrefix(slashPath, prefix) {
+			continue
 		}
+		relPath := reverseRenameRules(strings.TrimPrefix(slashPath, prefix), target.RenameRules)
+		return filepath.Join(folder.Source, filepath.FromSlash(relPath)), nil
 	}
-	return sourcePath
+
+	return "", fmt.Errorf("%s is not inside any target folder for %s", relTargetPath, target.Language)
 }
@@ -1,20 +1,72 @@
 package translate
 
+import (
+	"time"
+
+	"github.com/joeblew999/mon-house/pkg/translate/ai"
+)
+
 // Config represents the translate.json configuration
 type Config struct {
 	Source struct {
-		Language string `json:"language"`
-		Folder   string `json:"folder"`
+		Language     string          `json:"language"`
+		LanguageName string          `json:"language_name"` // Default: language code
+		Folder       string          `json:"folder"`        // Primary source folder, maps to each target's folder
+		Folders      []FolderMapping `json:"folders"`       // Additional source folders (e.g. furniture/shopping)
 	} `json:"source"`
-	Targets []TargetConfig `json:"targets"`
+	Targets   []TargetConfig `json:"targets"`
 	FileTypes struct {
 		Translatable []string `json:"translatable"`
 		CopyOnly     []string `json:"copy_only"`
 	} `json:"file_types"`
 	Paths struct {
-		Tasks  string `json:"tasks"`  // Default: "tasks"
-		Events string `json:"events"` // Default: ".mon-tool"
+		Tasks     string `json:"tasks"`     // Default: "tasks"
+		Events    string `json:"events"`    // Default: ".mon-tool"
+		Standards string `json:"standards"` // Default: "drawing-standards.json" (seeds the glossaries)
 	} `json:"paths"`
+	SVG            SVGConfig      `json:"svg"`
+	JSONDocuments  []JSONDocument `json:"json_documents"`
+	AI             AIConfig       `json:"ai"`
+	DoNotTranslate []string       `json:"do_not_translate"` // Terms kept as they are in every language (brands, codes); QA allows their words
+}
+
+// AIConfig selects the translate auto provider and how a task is split into requests
+type AIConfig struct {
+	ai.Config       // provider, model, endpoint, api_key_env, dictionary
+	BatchTokens int `json:"batch_tokens"` // Estimated output tokens per request (default: ai.DefaultBatchTokens)
+	Workers     int `json:"workers"`      // Requests in flight at once (default: 4)
+
+	Pricing ai.PriceTable `json:"pricing"` // USD per million tokens by model (prefix), merged over ai.DefaultPricing
+	Budget  BudgetConfig  `json:"budget"`
+}
+
+// BudgetConfig caps AI spending; spend is summed from the event log (0 = no cap)
+type BudgetConfig struct {
+	PerRunUSD   float64 `json:"per_run_usd"`
+	PerMonthUSD float64 `json:"per_month_usd"`
+}
+
+// SVGConfig selects what is translatable in SVG files
+// Every listed element is one unit (all its <tspan> lines together);
+// listed attributes are extracted from any element
+type SVGConfig struct {
+	Elements   []string `json:"elements"`   // Default: text, title, desc
+	Attributes []string `json:"attributes"` // Default: aria-label
+	WrapWidth  float64  `json:"wrap_width"` // Labels wider than this are wrapped into <tspan> lines on apply (0 = never)
+}
+
+// FolderMapping pairs a source folder with its per-language target folder
+type FolderMapping struct {
+	Source string `json:"source"` // e.g. "furniture/shopping"
+	Target string `json:"target"` // e.g. "furniture/{language}/shopping" ({language} = target language)
+}
+
+// JSONDocument is a JSON file with translatable strings (e.g. code/drawings.json)
+// Each target language gets its own variant of the file
+type JSONDocument struct {
+	Source string   `json:"source"` // Relative to root, e.g. "code/drawings.json"
+	Target string   `json:"target"` // Default: source with ".{language}" before the extension
+	Paths  []string `json:"paths"`  // jq paths to translatable strings, e.g. ".drawings.files[].title"
 }
 
 // TargetConfig represents a translation target language configuration
@@ -24,6 +76,7 @@ type TargetConfig struct {
 	Folder           string            `json:"folder"`
 	RenameRules      map[string]string `json:"rename_rules"`
 	TranslationNotes []string          `json:"translation_notes"`
+	Glossary         string            `json:"glossary"` // Default: "code/glossary.{language}.json"
 }
 
 // SyncAction represents a file operation action
@@ -31,21 +84,33 @@ type SyncAction struct {
 	Action string // "mkdir", "copy", "delete"
 	Source string // Source file path (for copy)
 	Target string // Target file/directory path
-	Type   string // "svg", "md", "other" (for copy)
+	Type   string // "svg", "md", "json", "other" (for copy)
 }
 
 // TextExtraction represents a single text element that needs translation
 type TextExtraction struct {
-	Line       int    `json:"line"`
-	XPath      string `json:"xpath,omitempty"`      // For SVG/XML
-	Context    string `json:"context,omitempty"`    // For Markdown (e.g., "heading", "paragraph")
-	SourceText string `json:"source_text"`
-	TargetText string `json:"target_text"`
+	ID           string            `json:"id"` // Stable ID: element path + sibling index + source hash
+	Line         int               `json:"line"`
+	XPath        string            `json:"xpath,omitempty"`   // For SVG/XML (jq path for JSON)
+	Context      string            `json:"context,omitempty"` // For Markdown (e.g., "heading", "paragraph")
+	SourceText   string            `json:"source_text"`
+	TargetText   string            `json:"target_text"`
+	Placeholders map[string]string `json:"placeholders,omitempty"` // Protected tokens (⟦1⟧) → link target, image source, inline code
+	Match        string            `json:"match,omitempty"`        // Translation memory match: "exact" or "fuzzy"
+	MatchScore   int               `json:"match_score,omitempty"`  // Similarity percentage of the memory match
+	Suggestion   string            `json:"suggestion,omitempty"`   // Fuzzy memory translation (not applied until copied to target_text)
+	Status       string            `json:"status,omitempty"`       // Review status: new, fuzzy, machine, flagged, reviewed or approved
+	Reviewer     string            `json:"reviewer,omitempty"`     // Who last reviewed, approved or rejected the translation
+	ReviewedAt   *time.Time        `json:"reviewed_at,omitempty"`
+	// Set by translate verify: target_text translated back, and its drift from source_text
+	BackTranslation string `json:"back_translation,omitempty"`
+	Drift           int    `json:"drift,omitempty"` // Percentage (0 = same wording)
 }
 
 // TaskFile represents a file that needs translation in a task
 type TaskFile struct {
 	Source      string           `json:"source"`
+	SourceHash  string           `json:"source_hash,omitempty"` // Content hash of the source when the task was generated
 	Target      string           `json:"target"`
 	Type        string           `json:"type"`
 	Extractions []TextExtraction `json:"extractions,omitempty"`
@@ -62,10 +127,29 @@ type Task struct {
 	Instructions     map[string][]string `json:"instructions"`
 }
 
+// GenerateStats represents statistics from generating a task
+type GenerateStats struct {
+	TotalExtractions int
+	ExactMatches     int // Pre-filled from translation memory
+	FuzzyMatches     int // Marked with a suggestion from translation memory
+}
+
 // ApplyStats represents statistics from applying translations
 type ApplyStats struct {
-	TotalExtractions  int
-	FilledExtractions int
-	FilesProcessed    int
-	FilesSkipped      int
+	TotalExtractions   int
+	FilledExtractions  int
+	AppliedExtractions int
+	FilesProcessed     int
+	FilesSkipped       int
+	Unresolved         []UnresolvedExtraction
+}
+
+// UnresolvedExtraction is a filled extraction whose ID could not be matched
+// to a text node in the target file, so its translation was not written
+type UnresolvedExtraction struct {
+	File       string
+	ID         string
+	Line       int
+	SourceText string
+	Reason     string
 }
@@ -0,0 +1,232 @@
+package translate
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/joeblew999/mon-house/pkg/translate/ai"
+)
+
+// DefaultDriftThreshold is the back-translation drift (0-1) at which a translation is flagged
+const DefaultDriftThreshold = 0.5
+
+// driftWord matches the words and numbers compared by BackTranslationDrift
+var driftWord = regexp.MustCompile(`[\p{L}\p{N}]+(?:[.,]\p{N}+)*`)
+
+// VerifyOptions controls how VerifyTask back-translates a task
+type VerifyOptions struct {
+	Threshold          float64       // Drift at or above which a translation is flagged (default: DefaultDriftThreshold)
+	All                bool          // Also verify reviewed and approved translations (default: machine and flagged only)
+	BatchTokens        int           // Estimated output tokens per request (default: ai.DefaultBatchTokens)
+	SourceLanguageName string        // Name of the task's source language, the back-translation target
+	Pricing            ai.PriceTable // Prices for cost estimates (default: ai.DefaultPricing)
+	BudgetUSD          float64       // Most the run may spend by estimate (0 = no limit)
+}
+
+// VerifyResult is the back-translation check of one extraction
+type VerifyResult struct {
+	File       string
+	ID         string
+	SourceText string
+	TargetText string
+	BackText   string  // Target text translated back into the source language
+	Drift      float64 // 0 = same wording as the source, 1 = nothing in common
+	Reason     string  // Why the translation was flagged ("" = passed)
+}
+
+// VerifyReport is the outcome of VerifyTask
+type VerifyReport struct {
+	Results []VerifyResult // Worst drift first
+	Checked int
+	Flagged int
+	Failed  []ai.ItemFailure // Extractions the translator returned no back-translation for
+	Usage   ai.Usage
+}
+
+// VerifyTask translates the filled target_text of a task back into the source
+// language and scores each back-translation against source_text
+// Flagged extractions get status "flagged" (passing ones that were flagged go back
+// to "machine"); every checked extraction records its back-translation and drift
+// The task is changed in memory only; the caller saves it
+func VerifyTask(ctx context.Context, task *Task, translator ai.Translator, options VerifyOptions) (*VerifyReport, error) {
+	threshold := options.Threshold
+	if threshold <= 0 {
+		threshold = DefaultDriftThreshold
+	}
+	pricing := options.Pricing
+	if pricing == nil {
+		pricing = ai.DefaultPricing
+	}
+
+	// Step 1: Collect the translations to verify (the target text is the source of the request)
+	var items []ai.TranslationItem
+	extractions := make(map[string]*TextExtraction)
+	files := make(map[string]string)
+	for fileIdx := range task.Files {
+		file := &task.Files[fileIdx]
+		for extIdx := range file.Extractions {
+			ext := &file.Extractions[extIdx]
+			if ext.TargetText == "" {
+				continue
+			}
+			if status := ExtractionStatus(*ext); !options.All && status != StatusMachine && status != StatusFlagged {
+				continue
+			}
+			id := fmt.Sprintf("%d", len(items))
+			items = append(items, ai.TranslationItem{ID: id, Context: ext.Context, SourceText: ext.TargetText})
+			extractions[id] = ext
+			files[id] = file.Target
+		}
+	}
+	report := &VerifyReport{}
+	if len(items) == 0 {
+		return report, nil
+	}
+
+	// Step 2: Build the reverse request and check the estimate against the budget
+	base := ai.TranslationRequest{
+		SourceLanguage: task.TargetLanguage,
+		TargetLanguage: task.SourceLanguage,
+		LanguageName:   options.SourceLanguageName,
+		Domain:         "architectural drawings",
+		Notes:          []string{"Translate literally, so the result can be compared with the original wording"},
+	}
+	if err := ai.CheckBatchTokens(translator, options.BatchTokens); err != nil {
+		return report, err
+	}
+	batches := ai.Batches(items, options.BatchTokens)
+	if options.BudgetUSD > 0 {
+		estimate := 0.0
+		for _, batch := range batches {
+			req := base
+			req.Items = batch
+			in, out := ai.EstimateRequest(&req)
+			cost, _ := pricing.Cost(translator.Name(), in, out)
+			estimate += cost
+		}
+		if estimate > options.BudgetUSD {
+			return report, fmt.Errorf("%w: verification estimated at $%.4f, budget $%.4f", ai.ErrBudgetExceeded, estimate, options.BudgetUSD)
+		}
+	}
+
+	// Step 3: Back-translate batch by batch (missing or invalid items are re-sent)
+	for _, batch := range batches {
+		outcome := translateBatch(ctx, translator, base, batch, pricing)
+		addUsage(&report.Usage, outcome.usage)
+		report.Failed = append(report.Failed, outcome.failures...)
+
+		// Step 4: Score each back-translation and update the review status
+		for _, item := range outcome.translations {
+			ext, ok := extractions[item.ID]
+			if !ok {
+				continue
+			}
+			result := VerifyResult{
+				File:       files[item.ID],
+				ID:         ext.ID,
+				SourceText: ext.SourceText,
+				TargetText: ext.TargetText,
+				BackText:   item.TargetText,
+				Drift:      BackTranslationDrift(ext.SourceText, item.TargetText),
+			}
+			if missing := missingNumbers(ext.SourceText, item.TargetText); len(missing) > 0 {
+				result.Reason = "numbers differ: " + strings.Join(missing, ", ")
+			} else if result.Drift >= threshold {
+				result.Reason = fmt.Sprintf("drift %.0f%%", result.Drift*100)
+			}
+
+			ext.BackTranslation = item.TargetText
+			ext.Drift = int(result.Drift*100 + 0.5)
+			if result.Reason != "" {
+				ext.Status = StatusFlagged
+				report.Flagged++
+			} else if ExtractionStatus(*ext) == StatusFlagged {
+				ext.Status = StatusMachine
+			}
+			report.Results = append(report.Results, result)
+			report.Checked++
+		}
+
+		if outcome.err != nil {
+			sortVerifyResults(report.Results)
+			return report, fmt.Errorf("back-translation failed after %d of %d items: %w", report.Checked, len(items), outcome.err)
+		}
+	}
+
+	sortVerifyResults(report.Results)
+	return report, nil
+}
+
+// BackTranslationDrift scores how far a back-translation strays from the source
+// text: the mean of the word overlap (Dice coefficient) and the character edit
+// distance, turned into a drift between 0 (same) and 1 (nothing in common)
+// Case, punctuation and ⟦n⟧ placeholders are ignored
+func BackTranslationDrift(source, back string) float64 {
+	sourceWords := driftWords(source)
+	backWords := driftWords(back)
+	if len(sourceWords) == 0 && len(backWords) == 0 {
+		return 0
+	}
+
+	// Word overlap, counting repeated words
+	counts := make(map[string]int)
+	for _, word := range sourceWords {
+		counts[word]++
+	}
+	shared := 0
+	for _, word := range backWords {
+		if counts[word] > 0 {
+			counts[word]--
+			shared++
+		}
+	}
+	overlap := 2 * float64(shared) / float64(len(sourceWords)+len(backWords))
+
+	// Character similarity of the normalized texts
+	a, b := []rune(strings.Join(sourceWords, " ")), []rune(strings.Join(backWords, " "))
+	longest := max(len(a), len(b))
+	chars := 1 - float64(levenshtein(a, b))/float64(longest)
+
+	return 1 - (overlap+chars)/2
+}
+
+// driftWords splits a text into lower-case words, folding simple plurals
+// ("rooms" and "room" are the same word)
+func driftWords(text string) []string {
+	text = strings.ToLower(qaPlaceholder.ReplaceAllString(text, " "))
+	var words []string
+	for _, word := range driftWord.FindAllString(text, -1) {
+		if len(word) > 3 && strings.HasSuffix(word, "s") && !strings.HasSuffix(word, "ss") {
+			word = strings.TrimSuffix(word, "s")
+		}
+		words = append(words, word)
+	}
+	return words
+}
+
+// missingNumbers lists the numbers of the source text that did not survive the
+// round trip (a changed dimension is never a wording difference)
+func missingNumbers(source, back string) []string {
+	backNumbers := qaNumbers(qaPlaceholder.ReplaceAllString(back, ""))
+	var missing []string
+	sourceNumbers := qaNumbers(qaPlaceholder.ReplaceAllString(source, ""))
+	for _, number := range sortedKeys(sourceNumbers) {
+		if backNumbers[number] < sourceNumbers[number] {
+			missing = append(missing, number)
+		}
+	}
+	return missing
+}
+
+// sortVerifyResults orders results flagged first, then by drift, worst first
+func sortVerifyResults(results []VerifyResult) {
+	sort.SliceStable(results, func(i, j int) bool {
+		if (results[i].Reason != "") != (results[j].Reason != "") {
+			return results[i].Reason != ""
+		}
+		return results[i].Drift > results[j].Drift
+	})
+}
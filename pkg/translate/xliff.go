@@ -0,0 +1,158 @@
+package translate
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+)
+
+// XLIFF 2.0 document structure (only the parts mon-tool reads and writes)
+// Spec: http://docs.oasis-open.org/xliff/xliff-core/v2.0/xliff-core-v2.0.html
+
+const xliffNamespace = "urn:oasis:names:tc:xliff:document:2.0"
+
+type xliffDocument struct {
+	XMLName xml.Name    `xml:"urn:oasis:names:tc:xliff:document:2.0 xliff"`
+	Version string      `xml:"version,attr"`
+	SrcLang string      `xml:"srcLang,attr"`
+	TrgLang string      `xml:"trgLang,attr,omitempty"`
+	Files   []xliffFile `xml:"file"`
+}
+
+type xliffFile struct {
+	ID       string      `xml:"id,attr"`
+	Original string      `xml:"original,attr,omitempty"` // Target path of the TaskFile
+	Notes    []xliffNote `xml:"notes>note,omitempty"`
+	Units    []xliffUnit `xml:"unit"`
+}
+
+type xliffUnit struct {
+	ID      string       `xml:"id,attr"`             // Sequential NMTOKEN (extraction IDs contain / [ #)
+	Name    string       `xml:"name,attr,omitempty"` // Extraction ID
+	Notes   []xliffNote  `xml:"notes>note,omitempty"`
+	Segment xliffSegment `xml:"segment"`
+}
+
+type xliffNote struct {
+	Category string `xml:"category,attr,omitempty"`
+	Text     string `xml:",chardata"`
+}
+
+type xliffSegment struct {
+	State  string      `xml:"state,attr,omitempty"` // "initial", "translated", "reviewed" or "final"
+	Source string      `xml:"source"`
+	Target *xliffValue `xml:"target"`
+}
+
+type xliffValue struct {
+	Text string `xml:",chardata"`
+}
+
+// exportXLIFF renders a task as an XLIFF 2.0 document
+// One <file> per TaskFile, one <unit> per TextExtraction
+func exportXLIFF(task *Task) ([]byte, error) {
+	doc := xliffDocument{
+		Version: "2.0",
+		SrcLang: task.SourceLanguage,
+		TrgLang: task.TargetLanguage,
+	}
+
+	for fileIdx, file := range task.Files {
+		xfile := xliffFile{
+			ID:       "f" + strconv.Itoa(fileIdx+1),
+			Original: file.Target,
+			Notes: []xliffNote{
+				{Category: "source", Text: file.Source},
+				{Category: "type", Text: file.Type},
+			},
+		}
+
+		for extIdx, ext := range file.Extractions {
+			unit := xliffUnit{
+				ID:   fmt.Sprintf("f%d-u%d", fileIdx+1, extIdx+1),
+				Name: ext.ID,
+				Segment: xliffSegment{
+					State:  "initial",
+					Source: ext.SourceText,
+				},
+			}
+			if ext.TargetText != "" {
+				unit.Segment.State = xliffState(ExtractionStatus(ext))
+				unit.Segment.Target = &xliffValue{Text: ext.TargetText}
+			}
+
+			// Location and context help the reviewer find the text in the drawing
+			location := fmt.Sprintf("line %d", ext.Line)
+			if ext.XPath != "" {
+				location += " " + ext.XPath
+			}
+			unit.Notes = append(unit.Notes, xliffNote{Category: "location", Text: location})
+			if ext.Context != "" {
+				unit.Notes = append(unit.Notes, xliffNote{Category: "context", Text: ext.Context})
+			}
+			if ext.Suggestion != "" {
+				unit.Notes = append(unit.Notes, xliffNote{
+					Category: "suggestion",
+					Text:     fmt.Sprintf("%s (%d%% memory match)", ext.Suggestion, ext.MatchScore),
+				})
+			}
+			for _, note := range task.TranslationNotes {
+				unit.Notes = append(unit.Notes, xliffNote{Category: "instruction", Text: note})
+			}
+
+			xfile.Units = append(xfile.Units, unit)
+		}
+
+		doc.Files = append(doc.Files, xfile)
+	}
+
+	data, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal XLIFF: %w", err)
+	}
+
+	return append([]byte(xml.Header), append(data, '\n')...), nil
+}
+
+// parseXLIFF reads translated units from an XLIFF 2.0 document
+func parseXLIFF(data []byte) ([]exchangeUnit, error) {
+	var doc xliffDocument
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse XLIFF: %w", err)
+	}
+
+	if doc.XMLName.Space != xliffNamespace || doc.Version != "2.0" {
+		return nil, fmt.Errorf("unsupported XLIFF document (need version 2.0, got %q)", doc.Version)
+	}
+
+	var units []exchangeUnit
+	for _, file := range doc.Files {
+		for _, unit := range file.Units {
+			target := ""
+			if unit.Segment.Target != nil {
+				target = unit.Segment.Target.Text
+			}
+			units = append(units, exchangeUnit{
+				File:       file.Original,
+				ID:         unit.Name,
+				SourceText: unit.Segment.Source,
+				TargetText: target,
+				Final:      unit.Segment.State == "final",
+			})
+		}
+	}
+
+	return units, nil
+}
+
+// xliffState maps the review status of a translated extraction to an XLIFF 2.0 segment state
+func xliffState(status string) string {
+	switch status {
+	case StatusApproved:
+		return "final"
+	case StatusReviewed:
+		return "reviewed"
+	default:
+		return "translated"
+	}
+}